@@ -0,0 +1,267 @@
+// Package accesslog records the rpc calls as json lines, one Entry per call.
+package accesslog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs-force-community/sophon-auth/core"
+	logging "github.com/ipfs/go-log/v2"
+
+	"github.com/ipfs-force-community/sophon-co/acl"
+	"github.com/ipfs-force-community/sophon-co/apiwrap"
+	"github.com/ipfs-force-community/sophon-co/co"
+	"github.com/ipfs-force-community/sophon-co/limiter"
+)
+
+var log = logging.Logger("access-log")
+
+// levels of the methods
+const (
+	// LevelNone means the calls are not logged
+	LevelNone = "none"
+	// LevelBasic logs the calls without the size of the responses
+	LevelBasic = "basic"
+	// LevelFull also logs the size of the responses, which are encoded once more for it
+	LevelFull = "full"
+)
+
+// error classes
+const (
+	ClassAccessDenied = "access_denied"
+	ClassRateLimited  = "rate_limited"
+	ClassNodeBusy     = "node_busy"
+	ClassNoNode       = "no_node"
+	ClassCanceled     = "canceled"
+	ClassTimeout      = "timeout"
+	ClassUpstream     = "upstream"
+)
+
+// maxErrorLen is the max length of the logged error messages
+const maxErrorLen = 256
+
+// Entry is a logged call
+type Entry struct {
+	Time       time.Time `json:"time"`
+	User       string    `json:"user,omitempty"`
+	Role       string    `json:"role,omitempty"`
+	Host       string    `json:"host,omitempty"`
+	Method     string    `json:"method"`
+	Hint       string    `json:"hint,omitempty"`
+	Nodes      []string  `json:"nodes,omitempty"`
+	DurationMs int64     `json:"duration_ms"`
+	ErrorClass string    `json:"error_class,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	Size       int       `json:"size,omitempty"`
+}
+
+// Option configures the access log
+type Option struct {
+	Path string
+	// MaxSize is the max size of the log file in MB before it's rotated
+	MaxSize int
+	// MaxBackups is the number of the rotated files kept
+	MaxBackups int
+	// Level is the default level of the methods, one of LevelNone, LevelBasic and LevelFull
+	Level string
+	// Methods overrides the level of the methods matching the patterns, see path.Match
+	Methods map[string]string
+}
+
+func validLevel(level string) bool {
+	return level == LevelNone || level == LevelBasic || level == LevelFull
+}
+
+// New opens the log file and constructs a Logger instance
+func New(opt Option) (*Logger, error) {
+	if opt.Level == "" {
+		opt.Level = LevelBasic
+	}
+	if !validLevel(opt.Level) {
+		return nil, fmt.Errorf("invalid access log level %q", opt.Level)
+	}
+
+	for pattern, level := range opt.Methods {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid method pattern %q: %w", pattern, err)
+		}
+		if !validLevel(level) {
+			return nil, fmt.Errorf("invalid access log level %q of %s", level, pattern)
+		}
+	}
+
+	w, err := newRotateWriter(opt.Path, int64(opt.MaxSize)<<20, opt.MaxBackups)
+	if err != nil {
+		return nil, fmt.Errorf("open access log %s: %w", opt.Path, err)
+	}
+
+	return &Logger{opt: opt, out: w}, nil
+}
+
+// Logger writes the entries of the calls to the log file
+type Logger struct {
+	opt Option
+	out io.WriteCloser
+}
+
+// Close closes the log file
+func (l *Logger) Close() error {
+	return l.out.Close()
+}
+
+// levelOf returns the level of the method, the patterns are matched in the alphabet order if more than one matches
+func (l *Logger) levelOf(method string) string {
+	level, matched := l.opt.Level, ""
+	for pattern, lv := range l.opt.Methods {
+		if ok, _ := path.Match(pattern, method); ok && (matched == "" || pattern < matched) {
+			level, matched = lv, pattern
+		}
+	}
+
+	return level
+}
+
+// Log writes the entry as a json line
+func (l *Logger) Log(entry *Entry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Errorf("marshal access log entry of %s: %s", entry.Method, err)
+		return
+	}
+
+	if _, err := l.out.Write(append(line, '\n')); err != nil {
+		log.Errorf("write access log: %s", err)
+	}
+}
+
+// Wrap builds a api.FullNodeStruct, which logs the calls of the methods of in
+func (l *Logger) Wrap(in api.FullNode) *api.FullNodeStruct {
+	return apiwrap.FullNode(in, l.handle)
+}
+
+// WrapFunctions wraps the methods of in into the func fields of out, which should be a pointer to
+// an Internal struct of the api proxy, so that the calls of the venus api are logged as well
+func (l *Logger) WrapFunctions(in interface{}, out interface{}) {
+	apiwrap.WrapInternal(in, out, l.handle)
+}
+
+func (l *Logger) handle(method string, fn reflect.Value, args []reflect.Value) []reflect.Value {
+	level := l.levelOf(method)
+	if level == LevelNone {
+		return fn.Call(args)
+	}
+
+	ctx := args[0].Interface().(context.Context)
+	caller := acl.CallerFromCtx(ctx)
+	host, _ := core.CtxGetTokenLocation(ctx)
+	entry := &Entry{
+		Time:   time.Now(),
+		User:   caller.User,
+		Role:   caller.Role,
+		Host:   host,
+		Method: method,
+		Hint:   routingHint(args[1:]),
+	}
+
+	var lk sync.Mutex
+	ctx = co.WithNodeRecorder(ctx, func(addr string) {
+		lk.Lock()
+		defer lk.Unlock()
+
+		for _, node := range entry.Nodes {
+			if node == addr {
+				return
+			}
+		}
+		entry.Nodes = append(entry.Nodes, addr)
+	})
+	args[0] = reflect.ValueOf(ctx)
+
+	out := fn.Call(args)
+
+	lk.Lock()
+	defer lk.Unlock()
+
+	entry.DurationMs = time.Since(entry.Time).Milliseconds()
+	if err := apiwrap.ResultError(out); err != nil {
+		entry.ErrorClass = ErrorClass(err)
+		entry.Error = err.Error()
+		if len(entry.Error) > maxErrorLen {
+			entry.Error = entry.Error[:maxErrorLen] + "..."
+		}
+	} else if level == LevelFull && len(out) > 1 {
+		entry.Size = responseSize(out[0])
+	}
+
+	l.Log(entry)
+	return out
+}
+
+// ErrorClass classifies the error of the call
+func ErrorClass(err error) string {
+	var limited *limiter.LimitedError
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, acl.ErrAccessDenied):
+		return ClassAccessDenied
+	case errors.As(err, &limited):
+		return ClassRateLimited
+	case errors.Is(err, co.ErrNodeBusy):
+		return ClassNodeBusy
+	case errors.Is(err, co.ErrNoNodeAvailable):
+		return ClassNoNode
+	case errors.Is(err, context.Canceled):
+		return ClassCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return ClassTimeout
+	default:
+		return ClassUpstream
+	}
+}
+
+var (
+	tipSetKeyType = reflect.TypeOf(types.TipSetKey{})
+	epochType     = reflect.TypeOf(abi.ChainEpoch(0))
+)
+
+// routingHint describes the params used to route the call, the heights and the tipset keys
+func routingHint(params []reflect.Value) string {
+	var hints []string
+	for _, p := range params {
+		switch p.Type() {
+		case epochType:
+			hints = append(hints, fmt.Sprintf("@%d", p.Int()))
+		case tipSetKeyType:
+			if tsk := p.Interface().(types.TipSetKey); !tsk.IsEmpty() {
+				hints = append(hints, tsk.String())
+			}
+		}
+	}
+
+	return strings.Join(hints, " ")
+}
+
+// responseSize returns the size of the json encoded result, 0 for the results can't be encoded, e.g. the channels
+func responseSize(result reflect.Value) int {
+	if result.Kind() == reflect.Chan {
+		return 0
+	}
+
+	b, err := json.Marshal(result.Interface())
+	if err != nil {
+		return 0
+	}
+	return len(b)
+}
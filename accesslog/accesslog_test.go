@@ -0,0 +1,149 @@
+package accesslog
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs-force-community/sophon-auth/core"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ipfs-force-community/sophon-co/acl"
+	"github.com/ipfs-force-community/sophon-co/co"
+)
+
+type mockFullNode struct {
+	api.FullNode
+}
+
+func (m *mockFullNode) ChainHead(context.Context) (*types.TipSet, error) {
+	return nil, fmt.Errorf("call ChainHead: %w", co.ErrNoNodeAvailable)
+}
+
+func (m *mockFullNode) ChainGetTipSetByHeight(context.Context, abi.ChainEpoch, types.TipSetKey) (*types.TipSet, error) {
+	return nil, nil
+}
+
+func (m *mockFullNode) Version(context.Context) (api.APIVersion, error) {
+	return api.APIVersion{Version: "mock"}, nil
+}
+
+func readEntries(t *testing.T, path string) []*Entry {
+	file, err := os.Open(path)
+	assert.NoError(t, err)
+	defer file.Close() // nolint:errcheck
+
+	var entries []*Entry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var e Entry
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		entries = append(entries, &e)
+	}
+	return entries
+}
+
+func TestLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+	l, err := New(Option{
+		Path:    path,
+		Methods: map[string]string{"Chain*": LevelBasic, "Version": LevelFull, "ChainNotify": LevelNone},
+	})
+	assert.NoError(t, err)
+
+	full := l.Wrap(&mockFullNode{})
+	ctx := core.CtxWithName(context.Background(), "alice")
+
+	_, err = full.ChainHead(ctx)
+	assert.ErrorIs(t, err, co.ErrNoNodeAvailable)
+	_, err = full.ChainGetTipSetByHeight(ctx, 100, types.EmptyTSK)
+	assert.NoError(t, err)
+	_, err = full.Version(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, l.Close())
+
+	entries := readEntries(t, path)
+	assert.Len(t, entries, 3)
+
+	assert.Equal(t, "ChainHead", entries[0].Method)
+	assert.Equal(t, "alice", entries[0].User)
+	assert.Equal(t, ClassNoNode, entries[0].ErrorClass)
+
+	assert.Equal(t, "@100", entries[1].Hint)
+	assert.Empty(t, entries[1].ErrorClass)
+	assert.Zero(t, entries[1].Size)
+
+	assert.Equal(t, "Version", entries[2].Method)
+	assert.Greater(t, entries[2].Size, 0)
+
+	assert.True(t, Filter{Method: "Chain*", ErrorsOnly: true}.Match(entries[0]))
+	assert.False(t, Filter{Method: "Chain*", ErrorsOnly: true}.Match(entries[1]))
+	assert.False(t, Filter{User: "bob"}.Match(entries[2]))
+	assert.False(t, Filter{Node: "127.0.0.1"}.Match(entries[2]))
+	assert.False(t, Filter{Since: time.Now().Add(time.Hour)}.Match(entries[2]))
+
+	_, err = New(Option{Path: path, Level: "verbose"})
+	assert.Error(t, err)
+}
+
+func TestErrorClass(t *testing.T) {
+	assert.Equal(t, ClassAccessDenied, ErrorClass(fmt.Errorf("%w: ChainHead", acl.ErrAccessDenied)))
+	assert.Equal(t, ClassNodeBusy, ErrorClass(co.ErrNodeBusy))
+	assert.Equal(t, ClassTimeout, ErrorClass(context.DeadlineExceeded))
+	assert.Equal(t, ClassUpstream, ErrorClass(fmt.Errorf("actor not found")))
+	assert.Empty(t, ErrorClass(nil))
+}
+
+func TestRotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+	w, err := newRotateWriter(path, 10, 2)
+	assert.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		_, err = w.Write([]byte(fmt.Sprintf("line %d\n", i)))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Close())
+
+	for file, content := range map[string]string{path: "line 3\n", path + ".1": "line 2\n", path + ".2": "line 1\n"} {
+		b, err := os.ReadFile(file)
+		assert.NoError(t, err)
+		assert.Equal(t, content, string(b))
+	}
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestRotateFailure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+	w, err := newRotateWriter(path, 10, 1)
+	assert.NoError(t, err)
+
+	// the backup path can't be replaced by the file
+	assert.NoError(t, os.MkdirAll(filepath.Join(path+".1", "dir"), 0o755))
+
+	for i := 0; i < 2; i++ {
+		_, err = w.Write([]byte(fmt.Sprintf("line %d\n", i)))
+		assert.NoError(t, err)
+	}
+
+	// the rotation succeeds once the backup path is freed
+	assert.NoError(t, os.RemoveAll(path+".1"))
+	_, err = w.Write([]byte("line 2\n"))
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+
+	for file, content := range map[string]string{path: "line 2\n", path + ".1": "line 0\nline 1\n"} {
+		b, err := os.ReadFile(file)
+		assert.NoError(t, err)
+		assert.Equal(t, content, string(b))
+	}
+}
@@ -0,0 +1,49 @@
+package accesslog
+
+import (
+	"path"
+	"strings"
+	"time"
+)
+
+// Filter selects the entries, the empty fields match all the entries.
+// Method is a pattern, see path.Match, Node matches the nodes containing it.
+type Filter struct {
+	Method     string
+	User       string
+	Node       string
+	ErrorsOnly bool
+	Since      time.Time
+}
+
+// Match returns true if the entry is selected by the filter
+func (f Filter) Match(e *Entry) bool {
+	if f.Method != "" {
+		if ok, _ := path.Match(f.Method, e.Method); !ok {
+			return false
+		}
+	}
+
+	if f.User != "" && f.User != e.User {
+		return false
+	}
+
+	if f.Node != "" {
+		matched := false
+		for _, node := range e.Nodes {
+			if strings.Contains(node, f.Node) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if f.ErrorsOnly && e.ErrorClass == "" {
+		return false
+	}
+
+	return f.Since.IsZero() || !e.Time.Before(f.Since)
+}
@@ -0,0 +1,113 @@
+package accesslog
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// rotateWriter writes to the file at path, and rotates it to path.1, path.2 ... when it exceeds maxSize bytes,
+// at most maxBackups rotated files are kept.
+type rotateWriter struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	lk   sync.Mutex
+	file *os.File
+	size int64
+}
+
+func newRotateWriter(path string, maxSize int64, maxBackups int) (*rotateWriter, error) {
+	w := &rotateWriter{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (w *rotateWriter) open() error {
+	file, size, err := openAppend(w.path)
+	if err != nil {
+		return err
+	}
+
+	w.file = file
+	w.size = size
+	return nil
+}
+
+func openAppend(path string) (*os.File, int64, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, 0, err
+	}
+
+	return file, info.Size(), nil
+}
+
+// Write writes p as a whole, the file is rotated before if p makes it exceed the max size.
+// If the rotation fails, p is still written to the current file, so that no entry is lost.
+func (w *rotateWriter) Write(p []byte) (int, error) {
+	w.lk.Lock()
+	defer w.lk.Unlock()
+
+	if w.maxSize > 0 && w.size > 0 && w.size+int64(len(p)) > w.maxSize {
+		if err := w.rotate(); err != nil {
+			log.Warnf("rotate %s: %s", w.path, err)
+		}
+	}
+
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+// rotate moves the files aside while the current one is still open, and swaps in the new file only once
+// it's opened, so the writer keeps a usable file whatever step fails.
+func (w *rotateWriter) rotate() error {
+	if w.maxBackups < 1 {
+		if err := os.Remove(w.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	} else {
+		for i := w.maxBackups - 1; i >= 1; i-- {
+			err := os.Rename(backupPath(w.path, i), backupPath(w.path, i+1))
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+
+		if err := os.Rename(w.path, backupPath(w.path, 1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	file, size, err := openAppend(w.path)
+	if err != nil {
+		return err
+	}
+
+	_ = w.file.Close()
+	w.file = file
+	w.size = size
+	return nil
+}
+
+func (w *rotateWriter) Close() error {
+	w.lk.Lock()
+	defer w.lk.Unlock()
+
+	return w.file.Close()
+}
+
+func backupPath(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
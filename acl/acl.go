@@ -0,0 +1,133 @@
+package acl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync/atomic"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/ipfs-force-community/metrics"
+	"github.com/ipfs-force-community/sophon-auth/core"
+	logging "github.com/ipfs/go-log/v2"
+	"go.opencensus.io/tag"
+
+	"github.com/ipfs-force-community/sophon-co/apiwrap"
+)
+
+var log = logging.Logger("acl")
+
+// ErrAccessDenied is returned if the method is denied by the policy
+var ErrAccessDenied = errors.New("access denied")
+
+var (
+	methodKey = tag.MustNewKey("method")
+	userKey   = tag.MustNewKey("user")
+)
+
+var deniedCalls = metrics.NewCounter("acl_denied", "calls denied by the acl policy", methodKey, userKey)
+
+type tokenKey struct{}
+
+// New constructs an ACL instance with the policy file
+func New(filePath string) (*ACL, error) {
+	a := &ACL{path: filePath}
+	if err := a.Reload(); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// ACL enforces the policy on the api calls, the policy can be reloaded at runtime
+type ACL struct {
+	path   string
+	policy atomic.Pointer[Policy]
+}
+
+// Reload reads the policy file again, the current policy is kept if the file is invalid
+func (a *ACL) Reload() error {
+	p, err := LoadPolicy(a.path)
+	if err != nil {
+		return fmt.Errorf("load acl policy from %s: %w", a.path, err)
+	}
+
+	a.policy.Store(p)
+	log.Infof("acl policy loaded from %s, %d rules, default %s", a.path, len(p.Rules), p.Default)
+	return nil
+}
+
+// Check checks whether the caller in the context can call the method
+func (a *ACL) Check(ctx context.Context, method string) error {
+	caller := CallerFromCtx(ctx)
+	allowed, rule := a.policy.Load().Check(caller, method)
+	if allowed {
+		return nil
+	}
+
+	host, _ := core.CtxGetTokenLocation(ctx)
+	log.Warnw("call denied", "method", method, "user", caller.User, "role", caller.Role, "host", host, "rule", rule)
+
+	mctx, _ := tag.New(ctx, tag.Upsert(methodKey, method), tag.Upsert(userKey, caller.User))
+	deniedCalls.Tick(mctx)
+
+	return fmt.Errorf("%w: %s", ErrAccessDenied, method)
+}
+
+// Wrap builds a api.FullNodeStruct, which checks the policy before calling the methods of in
+func (a *ACL) Wrap(in api.FullNode) *api.FullNodeStruct {
+	return apiwrap.FullNode(in, a.handle)
+}
+
+// WrapFunctions wraps the methods of in into the func fields of out, which should be a pointer to
+// an Internal struct of the api proxy, so that the methods of the venus api are checked as well
+func (a *ACL) WrapFunctions(in interface{}, out interface{}) {
+	apiwrap.WrapInternal(in, out, a.handle)
+}
+
+func (a *ACL) handle(method string, fn reflect.Value, args []reflect.Value) []reflect.Value {
+	if err := a.Check(args[0].Interface().(context.Context), method); err != nil {
+		return apiwrap.ErrorResults(fn.Type(), err)
+	}
+
+	return fn.Call(args)
+}
+
+// CallerFromCtx gets the identity of the caller set by sophon-auth and TokenHandler
+func CallerFromCtx(ctx context.Context) Caller {
+	var caller Caller
+	caller.User, _ = core.CtxGetName(ctx)
+	caller.Token, _ = ctx.Value(tokenKey{}).(string)
+
+	// the highest one is used as the role, since sophon-auth expands the permission to all the lower ones
+	if perms, ok := core.CtxGetPerm(ctx); ok {
+		for _, perm := range core.PermArr {
+			for _, p := range perms {
+				if p == perm {
+					caller.Role = perm
+				}
+			}
+		}
+	}
+
+	return caller
+}
+
+// TokenHandler puts the bearer token of the request into the context, so that rules can match the token
+func TokenHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get(core.AuthorizationHeader), "Bearer ")
+		if token == "" {
+			token = r.FormValue("token")
+		}
+
+		if token != "" {
+			r = r.WithContext(context.WithValue(r.Context(), tokenKey{}, token))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
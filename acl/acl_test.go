@@ -0,0 +1,118 @@
+package acl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs-force-community/sophon-auth/core"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFullNode struct {
+	api.FullNode
+}
+
+func (m *mockFullNode) ChainHead(context.Context) (*types.TipSet, error) {
+	return &types.TipSet{}, nil
+}
+
+func (m *mockFullNode) MpoolPush(context.Context, *types.SignedMessage) (cid.Cid, error) {
+	return cid.Undef, nil
+}
+
+func callerCtx(user, perm string) context.Context {
+	ctx := core.CtxWithName(context.Background(), user)
+	return core.CtxWithPerms(ctx, core.AdaptOldStrategy(perm))
+}
+
+func TestPolicyExample(t *testing.T) {
+	p, err := LoadPolicy("./policy_example.toml")
+	assert.NoError(t, err)
+
+	cases := []struct {
+		caller  Caller
+		method  string
+		allowed bool
+		rule    string
+	}{
+		{Caller{User: "sophon-messager-1", Role: "write"}, "MpoolPush", true, "messager"},
+		{Caller{User: "sophon-miner", Role: "write"}, "MpoolPush", false, "no-push"},
+		{Caller{User: "sophon-miner", Role: "write"}, "MinerGetBaseInfo", true, "default"},
+		{Caller{User: "explorer", Role: "read"}, "StateGetActor", true, "readonly"},
+		{Caller{User: "explorer", Role: "read"}, "WalletBalance", false, "readonly-others"},
+	}
+
+	for _, c := range cases {
+		allowed, rule := p.Check(c.caller, c.method)
+		assert.Equal(t, c.allowed, allowed, "%v %s", c.caller, c.method)
+		assert.Equal(t, c.rule, rule, "%v %s", c.caller, c.method)
+	}
+}
+
+func TestPolicyInvalid(t *testing.T) {
+	dir := t.TempDir()
+
+	file := filepath.Join(dir, "policy.toml")
+	assert.NoError(t, os.WriteFile(file, []byte(`Default = "maybe"`), 0o644))
+	_, err := LoadPolicy(file)
+	assert.Error(t, err)
+
+	assert.NoError(t, os.WriteFile(file, []byte("[[Rules]]\nAllow = [\"Chain[\"]"), 0o644))
+	_, err = LoadPolicy(file)
+	assert.Error(t, err)
+}
+
+func TestACLWrap(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "policy.toml")
+	assert.NoError(t, os.WriteFile(file, []byte("[[Rules]]\nUsers = [\"bob\"]\nDeny = [\"Mpool*\"]"), 0o644))
+
+	a, err := New(file)
+	assert.NoError(t, err)
+
+	full := a.Wrap(&mockFullNode{})
+
+	_, err = full.MpoolPush(callerCtx("bob", core.PermWrite), &types.SignedMessage{})
+	assert.ErrorIs(t, err, ErrAccessDenied)
+
+	_, err = full.ChainHead(callerCtx("bob", core.PermWrite))
+	assert.NoError(t, err)
+
+	_, err = full.MpoolPush(callerCtx("alice", core.PermWrite), &types.SignedMessage{})
+	assert.NoError(t, err)
+
+	// reloaded at runtime, an invalid file keeps the current policy
+	assert.NoError(t, os.WriteFile(file, []byte(`Default = "maybe"`), 0o644))
+	assert.Error(t, a.Reload())
+	_, err = full.MpoolPush(callerCtx("bob", core.PermWrite), &types.SignedMessage{})
+	assert.ErrorIs(t, err, ErrAccessDenied)
+
+	assert.NoError(t, os.WriteFile(file, []byte("Default = \"deny\"\n[[Rules]]\nTokens = [\"secret\"]\nAllow = [\"*\"]"), 0o644))
+	assert.NoError(t, a.Reload())
+	_, err = full.ChainHead(callerCtx("alice", core.PermRead))
+	assert.ErrorIs(t, err, ErrAccessDenied)
+
+	// token is matched after TokenHandler
+	var ctx context.Context
+	hnd := TokenHandler(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	}))
+	req := httptest.NewRequest(http.MethodPost, "/rpc/v1", nil)
+	req.Header.Set(core.AuthorizationHeader, "Bearer secret")
+	hnd.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, Caller{Token: "secret"}, CallerFromCtx(ctx))
+	_, err = full.ChainHead(ctx)
+	assert.NoError(t, err)
+}
+
+func TestCallerRole(t *testing.T) {
+	assert.Equal(t, Caller{User: "bob", Role: core.PermSign}, CallerFromCtx(callerCtx("bob", core.PermSign)))
+	assert.Equal(t, Caller{User: "bob", Role: core.PermRead}, CallerFromCtx(callerCtx("bob", core.PermRead)))
+}
@@ -0,0 +1,132 @@
+package acl
+
+import (
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/pelletier/go-toml"
+)
+
+// actions of the policy
+const (
+	ActionAllow = "allow"
+	ActionDeny  = "deny"
+)
+
+// Rule grants or denies methods to the matched callers.
+// A caller matches the rule if any of Users, Tokens and Roles matches, a rule without any of them matches all the callers.
+// Users, Allow and Deny are glob patterns, such as `Mpool*`; Tokens and Roles must be the exact values.
+type Rule struct {
+	Name   string
+	Users  []string
+	Tokens []string
+	Roles  []string
+	Allow  []string
+	Deny   []string
+}
+
+// Policy is a list of rules which are checked in order,
+// the first rule matches both the caller and the method decides, Default is used if none of them does.
+type Policy struct {
+	Default string
+	Rules   []Rule
+}
+
+// Caller is the identity of the requests
+type Caller struct {
+	User  string
+	Token string
+	Role  string
+}
+
+// LoadPolicy reads the policy from the given toml file
+func LoadPolicy(filePath string) (*Policy, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Policy{Default: ActionAllow}
+	if err := toml.Unmarshal(data, p); err != nil {
+		return nil, err
+	}
+
+	return p, p.validate()
+}
+
+func (p *Policy) validate() error {
+	if p.Default != ActionAllow && p.Default != ActionDeny {
+		return fmt.Errorf("invalid default action %q", p.Default)
+	}
+
+	for i, rule := range p.Rules {
+		for _, patterns := range [][]string{rule.Users, rule.Allow, rule.Deny} {
+			for _, pattern := range patterns {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return fmt.Errorf("rule #%d %s: invalid pattern %q: %w", i, rule.Name, pattern, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Check checks whether the caller can call the method, the name of the deciding rule is returned as well.
+func (p *Policy) Check(caller Caller, method string) (bool, string) {
+	for i, rule := range p.Rules {
+		if !rule.matchCaller(caller) {
+			continue
+		}
+
+		name := rule.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", i)
+		}
+
+		if matchAny(rule.Deny, method) {
+			return false, name
+		}
+
+		if matchAny(rule.Allow, method) {
+			return true, name
+		}
+	}
+
+	return p.Default == ActionAllow, "default"
+}
+
+func (r *Rule) matchCaller(caller Caller) bool {
+	if len(r.Users) == 0 && len(r.Tokens) == 0 && len(r.Roles) == 0 {
+		return true
+	}
+
+	if caller.User != "" && matchAny(r.Users, caller.User) {
+		return true
+	}
+
+	for _, token := range r.Tokens {
+		if caller.Token != "" && token == caller.Token {
+			return true
+		}
+	}
+
+	for _, role := range r.Roles {
+		if caller.Role != "" && role == caller.Role {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -223,42 +223,6 @@ type Proxy interface {
 	// StateSectorExpiration returns epoch at which given sector will expire
 	StateSectorExpiration(context.Context, address.Address, abi.SectorNumber, types.TipSetKey) (*lminer.SectorExpiration, error) //perm:read
 
-	// StateSearchMsg looks back up to limit epochs in the chain for a message, and returns its receipt and the tipset where it was executed
-	//
-	// NOTE: If a replacing message is found on chain, this method will return
-	// a MsgLookup for the replacing message - the MsgLookup.Message will be a different
-	// CID than the one provided in the 'cid' param, MsgLookup.Receipt will contain the
-	// result of the execution of the replacing message.
-	//
-	// If the caller wants to ensure that exactly the requested message was executed,
-	// they must check that MsgLookup.Message is equal to the provided 'cid', or set the
-	// `allowReplaced` parameter to false. Without this check, and with `allowReplaced`
-	// set to true, both the requested and original message may appear as
-	// successfully executed on-chain, which may look like a double-spend.
-	//
-	// A replacing message is a message with a different CID, any of Gas values, and
-	// different signature, but with all other parameters matching (source/destination,
-	// nonce, params, etc.)
-	StateSearchMsg(ctx context.Context, from types.TipSetKey, msg cid.Cid, limit abi.ChainEpoch, allowReplaced bool) (*api.MsgLookup, error) //perm:read
-	// StateWaitMsg looks back up to limit epochs in the chain for a message.
-	// If not found, it blocks until the message arrives on chain, and gets to the
-	// indicated confidence depth.
-	//
-	// NOTE: If a replacing message is found on chain, this method will return
-	// a MsgLookup for the replacing message - the MsgLookup.Message will be a different
-	// CID than the one provided in the 'cid' param, MsgLookup.Receipt will contain the
-	// result of the execution of the replacing message.
-	//
-	// If the caller wants to ensure that exactly the requested message was executed,
-	// they must check that MsgLookup.Message is equal to the provided 'cid', or set the
-	// `allowReplaced` parameter to false. Without this check, and with `allowReplaced`
-	// set to true, both the requested and original message may appear as
-	// successfully executed on-chain, which may look like a double-spend.
-	//
-	// A replacing message is a message with a different CID, any of Gas values, and
-	// different signature, but with all other parameters matching (source/destination,
-	// nonce, params, etc.)
-	StateWaitMsg(ctx context.Context, cid cid.Cid, confidence uint64, limit abi.ChainEpoch, allowReplaced bool) (*api.MsgLookup, error) //perm:read
 	// StateNetworkVersion returns the network version at the given tipset
 	StateNetworkVersion(context.Context, types.TipSetKey) (network.Version, error) //perm:read
 	// StateNetworkName returns the name of the network the node is synced to
@@ -375,22 +339,10 @@ type Proxy interface {
 	// based on current chain conditions
 	MpoolPushMessage(ctx context.Context, msg *types.Message, spec *api.MessageSendSpec) (*types.SignedMessage, error) //perm:sign
 
-	// GasEstimateFeeCap estimates gas fee cap
-	GasEstimateFeeCap(context.Context, *types.Message, int64, types.TipSetKey) (types.BigInt, error) //perm:read
-
 	// GasEstimateGasLimit estimates gas used by the message and returns it.
 	// It fails if message fails to execute.
 	GasEstimateGasLimit(context.Context, *types.Message, types.TipSetKey) (int64, error) //perm:read
 
-	// GasEstimateGasPremium estimates what gas price should be used for a
-	// message to have high likelihood of inclusion in `nblocksincl` epochs.
-
-	GasEstimateGasPremium(_ context.Context, nblocksincl uint64,
-		sender address.Address, gaslimit int64, tsk types.TipSetKey) (types.BigInt, error) //perm:read
-
-	// GasEstimateMessageGas estimates gas values for unset message gas fields
-	GasEstimateMessageGas(context.Context, *types.Message, *api.MessageSendSpec, types.TipSetKey) (*types.Message, error) //perm:read
-
 	// MethodGroup: Sync
 	// The Sync method group contains methods for interacting with and
 	// observing the lotus sync service.
@@ -398,9 +350,6 @@ type Proxy interface {
 	// SyncState returns the current status of the lotus sync system.
 	SyncState(context.Context) (*api.SyncState, error) //perm:read
 
-	// venus specify
-	GasBatchEstimateMessageGas(ctx context.Context, estimateMessages []*api.EstimateMessage, fromNonce uint64, tsk types.TipSetKey) ([]*api.EstimateResult, error) //perm:read
-
 	MpoolSelects(context.Context, types.TipSetKey, []float64) ([][]*types.SignedMessage, error) //perm:read
 
 	MpoolPublishMessage(ctx context.Context, smsg *types.SignedMessage) error //perm:write
@@ -656,6 +605,116 @@ type Proxy interface {
 
 	NetAddrsListen(context.Context) (peer.AddrInfo, error)  //perm:read
 	NetProtectAdd(ctx context.Context, acl []peer.ID) error //perm:admin
+
+	// Read-only methods, they do not touch the wallet nor mutate the state of the upstream
+
+	ChainGetNode(ctx context.Context, p string) (*api.IpldObject, error) //perm:read
+
+	// ChainExport returns a stream of bytes with CAR dump of chain data.
+	// The exported chain data includes the header chain from the given tipset
+	// back to genesis, the entire genesis state, and the most recent 'nroots'
+	// state trees.
+	// If oldmsgskip is set, messages from before the requested roots are also not included.
+	ChainExport(ctx context.Context, nroots abi.ChainEpoch, oldmsgskip bool, tsk types.TipSetKey) (<-chan []byte, error) //perm:read
+
+	// SyncCheckBad checks if a block was marked as bad, and if it was, returns
+	// the reason.
+	SyncCheckBad(ctx context.Context, bcid cid.Cid) (string, error) //perm:read
+
+	// SyncValidateTipset indicates whether the provided tipset is valid or not
+	SyncValidateTipset(ctx context.Context, tsk types.TipSetKey) (bool, error) //perm:read
+
+	MpoolSub(context.Context) (<-chan api.MpoolUpdate, error) //perm:read
+
+	// MpoolCheckMessages performs logical checks on a batch of messages
+	MpoolCheckMessages(context.Context, []*api.MessagePrototype) ([][]api.MessageCheckStatus, error) //perm:read
+
+	// MpoolCheckPendingMessages performs logical checks for all pending messages from a given address
+	MpoolCheckPendingMessages(context.Context, address.Address) ([][]api.MessageCheckStatus, error) //perm:read
+
+	// MpoolCheckReplaceMessages performs logical checks on pending messages with replacement
+	MpoolCheckReplaceMessages(context.Context, []*types.Message) ([][]api.MessageCheckStatus, error) //perm:read
+
+	// StateReplay replays a given message, assuming it was included in a block in the specified tipset.
+	//
+	// If a tipset key is provided, and a replacing message is found on chain,
+	// the method will return an error saying that the message wasn't found
+	//
+	// If no tipset key is provided, the appropriate tipset is looked up, and if
+	// the message was gas-repriced, the on-chain message will be replayed - in
+	// that case the returned InvocResult.MsgCid will not match the Cid param
+	//
+	// If the caller wants to ensure that exactly the requested message was executed,
+	// they MUST check that InvocResult.MsgCid is equal to the provided Cid.
+	// Without this check both the requested and original message may appear as
+	// successfully executed on-chain, which may look like a double-spend.
+	//
+	// A replacing message is a message with a different CID, any of Gas values, and
+	// different signature, but with all other parameters matching (source/destination,
+	// nonce, params, etc.)
+	StateReplay(context.Context, types.TipSetKey, cid.Cid) (*api.InvocResult, error) //perm:read
+
+	// StateListMessages looks back and returns all messages with a matching to or from address, stopping at the given height.
+	StateListMessages(ctx context.Context, match *api.MessageMatch, tsk types.TipSetKey, toht abi.ChainEpoch) ([]cid.Cid, error) //perm:read
+
+	// StateCompute is a flexible command that applies the given messages on the given tipset.
+	// The messages are run as though the VM were at the provided height.
+	//
+	// When called, StateCompute will:
+	// - Load the provided tipset, or use the current chain head if not provided
+	// - Compute the tipset state of the provided tipset on top of the parent state
+	//   - (note that this step runs before vmheight is applied to the execution)
+	//   - Execute state upgrade if any were scheduled at the epoch, or in null
+	//     blocks preceding the tipset
+	//   - Call the cron actor on null blocks preceding the tipset
+	//   - For each block in the tipset
+	//     - Apply messages in blocks in the specified
+	//     - Award block reward by calling the reward actor
+	//   - Call the cron actor for the current epoch
+	// - If the specified vmheight is higher than the current epoch, apply any
+	//   needed state upgrades to the state
+	// - Apply the specified messages to the state
+	//
+	// The vmheight parameter sets VM execution epoch, and can be used to simulate
+	// message execution in different network versions. If the specified vmheight
+	// epoch is higher than the epoch of the specified tipset, any state upgrades
+	// until the vmheight will be executed on the state before applying messages
+	// specified by the user.
+	//
+	// Note that the initial tipset state computation is not affected by the
+	// vmheight parameter - only the messages in the `apply` set are
+	//
+	// If the caller wants to simply compute the state, vmheight should be set to
+	// the epoch of the specified tipset.
+	//
+	// Messages in the `apply` parameter must have the correct nonces, and gas
+	// values set.
+	StateCompute(context.Context, abi.ChainEpoch, []*types.Message, types.TipSetKey) (*api.ComputeStateOutput, error) //perm:read
+
+	// MsigGetAvailableBalance returns the portion of a multisig's balance that can be withdrawn or spent
+	MsigGetAvailableBalance(context.Context, address.Address, types.TipSetKey) (types.BigInt, error) //perm:read
+
+	// MsigGetVestingSchedule returns the vesting details of a given multisig.
+	MsigGetVestingSchedule(context.Context, address.Address, types.TipSetKey) (api.MsigVesting, error) //perm:read
+
+	// MsigGetVested returns the amount of FIL that vested in a multisig in a certain period.
+	// It takes the following params: <multisig address>, <start epoch>, <end epoch>
+	MsigGetVested(context.Context, address.Address, types.TipSetKey, types.TipSetKey) (types.BigInt, error) //perm:read
+
+	// MsigGetPending returns pending transactions for the given multisig
+	// wallet. Once pending transactions are fully approved, they will no longer
+	// appear here.
+	MsigGetPending(context.Context, address.Address, types.TipSetKey) ([]*api.MsigTransaction, error) //perm:read
+
+	PaychList(context.Context) ([]address.Address, error)                                                            //perm:read
+	PaychStatus(context.Context, address.Address) (*api.PaychStatus, error)                                          //perm:read
+	PaychVoucherCheckValid(context.Context, address.Address, *paych.SignedVoucher) error                             //perm:read
+	PaychVoucherCheckSpendable(context.Context, address.Address, *paych.SignedVoucher, []byte, []byte) (bool, error) //perm:read
+
+	// ChainBlockstoreInfo returns some basic information about the blockstore
+	ChainBlockstoreInfo(context.Context) (map[string]interface{}, error) //perm:read
+
+	NodeStatus(ctx context.Context, inclChainStatus bool) (api.NodeStatus, error) //perm:read
 }
 
 // Local is a subset of api.FullNode.
@@ -665,6 +724,92 @@ type Local interface {
 	// First message is guaranteed to be of len == 1, and type == 'current'.
 	ChainNotify(context.Context) (<-chan []*api.HeadChange, error)
 	ChainHead(context.Context) (*types.TipSet, error)
+
+	// MethodGroup: Common
+	// The Common methods describe sophon-co itself, rather than any of the upstream nodes.
+
+	// Discover returns an OpenRPC document describing an RPC API.
+	Discover(ctx context.Context) (apitypes.OpenRPCDocument, error) //perm:read
+
+	// ID returns peerID of libp2p node backing this API
+	ID(context.Context) (peer.ID, error) //perm:read
+
+	LogList(context.Context) ([]string, error)         //perm:write
+	LogSetLevel(context.Context, string, string) error //perm:write
+
+	// Session returns a random UUID of api provider session
+	Session(context.Context) (uuid.UUID, error) //perm:read
+
+	// StartTime returns node start time
+	StartTime(context.Context) (time.Time, error) //perm:read
+
+	Closing(context.Context) (<-chan struct{}, error) //perm:read
+
+	// StateSearchMsg looks back up to limit epochs in the chain for a message, and returns its receipt and the tipset where it was executed
+	//
+	// NOTE: If a replacing message is found on chain, this method will return
+	// a MsgLookup for the replacing message - the MsgLookup.Message will be a different
+	// CID than the one provided in the 'cid' param, MsgLookup.Receipt will contain the
+	// result of the execution of the replacing message.
+	//
+	// If the caller wants to ensure that exactly the requested message was executed,
+	// they must check that MsgLookup.Message is equal to the provided 'cid', or set the
+	// `allowReplaced` parameter to false. Without this check, and with `allowReplaced`
+	// set to true, both the requested and original message may appear as
+	// successfully executed on-chain, which may look like a double-spend.
+	//
+	// A replacing message is a message with a different CID, any of Gas values, and
+	// different signature, but with all other parameters matching (source/destination,
+	// nonce, params, etc.)
+	StateSearchMsg(ctx context.Context, from types.TipSetKey, msg cid.Cid, limit abi.ChainEpoch, allowReplaced bool) (*api.MsgLookup, error) //perm:read
+	// StateWaitMsg looks back up to limit epochs in the chain for a message.
+	// If not found, it blocks until the message arrives on chain, and gets to the
+	// indicated confidence depth.
+	//
+	// NOTE: If a replacing message is found on chain, this method will return
+	// a MsgLookup for the replacing message - the MsgLookup.Message will be a different
+	// CID than the one provided in the 'cid' param, MsgLookup.Receipt will contain the
+	// result of the execution of the replacing message.
+	//
+	// If the caller wants to ensure that exactly the requested message was executed,
+	// they must check that MsgLookup.Message is equal to the provided 'cid', or set the
+	// `allowReplaced` parameter to false. Without this check, and with `allowReplaced`
+	// set to true, both the requested and original message may appear as
+	// successfully executed on-chain, which may look like a double-spend.
+	//
+	// A replacing message is a message with a different CID, any of Gas values, and
+	// different signature, but with all other parameters matching (source/destination,
+	// nonce, params, etc.)
+	StateWaitMsg(ctx context.Context, cid cid.Cid, confidence uint64, limit abi.ChainEpoch, allowReplaced bool) (*api.MsgLookup, error) //perm:read
+	// StateSearchMsgLimited looks back up to limit epochs in the chain for a message, and returns its receipt and the tipset where it was executed
+	StateSearchMsgLimited(ctx context.Context, msg cid.Cid, limit abi.ChainEpoch) (*api.MsgLookup, error) //perm:read
+	// StateWaitMsgLimited looks back up to limit epochs in the chain for a message.
+	// If not found, it blocks until the message arrives on chain, and gets to the
+	// indicated confidence depth.
+	StateWaitMsgLimited(ctx context.Context, cid cid.Cid, confidence uint64, limit abi.ChainEpoch) (*api.MsgLookup, error) //perm:read
+
+	// GasEstimateFeeCap estimates gas fee cap
+	GasEstimateFeeCap(context.Context, *types.Message, int64, types.TipSetKey) (types.BigInt, error) //perm:read
+
+	// GasEstimateGasPremium estimates what gas price should be used for a
+	// message to have high likelihood of inclusion in `nblocksincl` epochs.
+	GasEstimateGasPremium(_ context.Context, nblocksincl uint64,
+		sender address.Address, gaslimit int64, tsk types.TipSetKey) (types.BigInt, error) //perm:read
+
+	// GasEstimateMessageGas estimates gas values for unset message gas fields
+	GasEstimateMessageGas(context.Context, *types.Message, *api.MessageSendSpec, types.TipSetKey) (*types.Message, error) //perm:read
+
+	// venus specify
+	GasBatchEstimateMessageGas(ctx context.Context, estimateMessages []*api.EstimateMessage, fromNonce uint64, tsk types.TipSetKey) ([]*api.EstimateResult, error) //perm:read
+
+	// StateDecodeParams attempts to decode the provided params, based on the recipient actor address and method number.
+	StateDecodeParams(ctx context.Context, toAddr address.Address, method abi.MethodNum, params []byte, tsk types.TipSetKey) (interface{}, error) //perm:read
+
+	// StateEncodeParams attempts to encode the provided json params to the binary form of the given actor method
+	StateEncodeParams(ctx context.Context, toActCode cid.Cid, method abi.MethodNum, params json.RawMessage) ([]byte, error) //perm:read
+
+	// WalletValidateAddress validates whether a given string can be decoded as a well-formed address
+	WalletValidateAddress(context.Context, string) (address.Address, error) //perm:read
 }
 
 // UnSupport is a subset of api.FullNode
@@ -715,26 +860,9 @@ type UnSupport interface {
 
 	// MethodGroup: Common
 
-	// Discover returns an OpenRPC document describing an RPC API.
-	Discover(ctx context.Context) (apitypes.OpenRPCDocument, error) //perm:read
-
-	// ID returns peerID of libp2p node backing this API
-	ID(context.Context) (peer.ID, error) //perm:read
-
-	LogList(context.Context) ([]string, error)         //perm:write
-	LogSetLevel(context.Context, string, string) error //perm:write
-
 	// trigger graceful shutdown
 	Shutdown(context.Context) error //perm:admin
 
-	// Session returns a random UUID of api provider session
-	Session(context.Context) (uuid.UUID, error) //perm:read
-
-	// StartTime returns node start time
-	StartTime(context.Context) (time.Time, error) //perm:read
-
-	Closing(context.Context) (<-chan struct{}, error) //perm:read
-
 	// ChainDeleteObj deletes node referenced by the given CID
 	ChainDeleteObj(context.Context, cid.Cid) error //perm:admin
 
@@ -744,15 +872,6 @@ type UnSupport interface {
 	// ChainSetHead forcefully sets current chain head. Use with caution.
 	ChainSetHead(context.Context, types.TipSetKey) error //perm:admin
 
-	ChainGetNode(ctx context.Context, p string) (*api.IpldObject, error) //perm:read
-
-	// ChainExport returns a stream of bytes with CAR dump of chain data.
-	// The exported chain data includes the header chain from the given tipset
-	// back to genesis, the entire genesis state, and the most recent 'nroots'
-	// state trees.
-	// If oldmsgskip is set, messages from before the requested roots are also not included.
-	ChainExport(ctx context.Context, nroots abi.ChainEpoch, oldmsgskip bool, tsk types.TipSetKey) (<-chan []byte, error) //perm:read
-
 	// ChainExportRangeInternal triggers the export of a chain
 	// CAR-snapshot directly to disk. It is similar to ChainExport,
 	// except, depending on options, the snapshot can include receipts,
@@ -776,13 +895,6 @@ type UnSupport interface {
 	// SyncUnmarkAllBad purges bad block cache, making it possible to sync to chains previously marked as bad
 	SyncUnmarkAllBad(ctx context.Context) error //perm:admin
 
-	// SyncCheckBad checks if a block was marked as bad, and if it was, returns
-	// the reason.
-	SyncCheckBad(ctx context.Context, bcid cid.Cid) (string, error) //perm:read
-
-	// SyncValidateTipset indicates whether the provided tipset is valid or not
-	SyncValidateTipset(ctx context.Context, tsk types.TipSetKey) (bool, error) //perm:read
-
 	// MethodGroup: Mpool
 	// The Mpool methods are for interacting with the message pool. The message pool
 	// manages all incoming and outgoing 'messages' going over the network.
@@ -793,21 +905,12 @@ type UnSupport interface {
 	// MpoolBatchPushMessage batch pushes a unsigned message to mempool.
 	MpoolBatchPushMessage(context.Context, []*types.Message, *api.MessageSendSpec) ([]*types.SignedMessage, error) //perm:sign
 
-	MpoolSub(context.Context) (<-chan api.MpoolUpdate, error) //perm:read
-
 	// MpoolClear clears pending messages from the mpool
 	MpoolClear(context.Context, bool) error //perm:write
 
 	// MpoolSetConfig sets the mpool config to (a copy of) the supplied config
 	MpoolSetConfig(context.Context, *types.MpoolConfig) error //perm:admin
 
-	// MpoolCheckMessages performs logical checks on a batch of messages
-	MpoolCheckMessages(context.Context, []*api.MessagePrototype) ([][]api.MessageCheckStatus, error) //perm:read
-	// MpoolCheckPendingMessages performs logical checks for all pending messages from a given address
-	MpoolCheckPendingMessages(context.Context, address.Address) ([][]api.MessageCheckStatus, error) //perm:read
-	// MpoolCheckReplaceMessages performs logical checks on pending messages with replacement
-	MpoolCheckReplaceMessages(context.Context, []*types.Message) ([][]api.MessageCheckStatus, error) //perm:read
-
 	// // UX ?
 
 	// MethodGroup: Wallet
@@ -833,15 +936,6 @@ type UnSupport interface {
 	WalletImport(context.Context, *types.KeyInfo) (address.Address, error) //perm:admin
 	// WalletDelete deletes an address from the wallet.
 	WalletDelete(context.Context, address.Address) error //perm:admin
-	// WalletValidateAddress validates whether a given string can be decoded as a well-formed address
-	WalletValidateAddress(context.Context, string) (address.Address, error) //perm:read
-
-	// StateSearchMsgLimited looks back up to limit epochs in the chain for a message, and returns its receipt and the tipset where it was executed
-	StateSearchMsgLimited(ctx context.Context, msg cid.Cid, limit abi.ChainEpoch) (*api.MsgLookup, error)
-	// StateWaitMsgLimited looks back up to limit epochs in the chain for a message.
-	// If not found, it blocks until the message arrives on chain, and gets to the
-	// indicated confidence depth.
-	StateWaitMsgLimited(ctx context.Context, cid cid.Cid, confidence uint64, limit abi.ChainEpoch) (*api.MsgLookup, error)
 
 	// Other
 
@@ -850,80 +944,10 @@ type UnSupport interface {
 	// Most methods take a TipSetKey as a parameter. The state looked up is the parent state of the tipset.
 	// A nil TipSetKey can be provided as a param, this will cause the heaviest tipset in the chain to be used.
 
-	// StateReplay replays a given message, assuming it was included in a block in the specified tipset.
-	//
-	// If a tipset key is provided, and a replacing message is found on chain,
-	// the method will return an error saying that the message wasn't found
-	//
-	// If no tipset key is provided, the appropriate tipset is looked up, and if
-	// the message was gas-repriced, the on-chain message will be replayed - in
-	// that case the returned InvocResult.MsgCid will not match the Cid param
-	//
-	// If the caller wants to ensure that exactly the requested message was executed,
-	// they MUST check that InvocResult.MsgCid is equal to the provided Cid.
-	// Without this check both the requested and original message may appear as
-	// successfully executed on-chain, which may look like a double-spend.
-	//
-	// A replacing message is a message with a different CID, any of Gas values, and
-	// different signature, but with all other parameters matching (source/destination,
-	// nonce, params, etc.)
-	StateReplay(context.Context, types.TipSetKey, cid.Cid) (*api.InvocResult, error) //perm:read
-	// StateListMessages looks back and returns all messages with a matching to or from address, stopping at the given height.
-	StateListMessages(ctx context.Context, match *api.MessageMatch, tsk types.TipSetKey, toht abi.ChainEpoch) ([]cid.Cid, error) //perm:read
-	// StateDecodeParams attempts to decode the provided params, based on the recipient actor address and method number.
-	StateDecodeParams(ctx context.Context, toAddr address.Address, method abi.MethodNum, params []byte, tsk types.TipSetKey) (interface{}, error) //perm:read
-
-	// StateCompute is a flexible command that applies the given messages on the given tipset.
-	// The messages are run as though the VM were at the provided height.
-	//
-	// When called, StateCompute will:
-	// - Load the provided tipset, or use the current chain head if not provided
-	// - Compute the tipset state of the provided tipset on top of the parent state
-	//   - (note that this step runs before vmheight is applied to the execution)
-	//   - Execute state upgrade if any were scheduled at the epoch, or in null
-	//     blocks preceding the tipset
-	//   - Call the cron actor on null blocks preceding the tipset
-	//   - For each block in the tipset
-	//     - Apply messages in blocks in the specified
-	//     - Award block reward by calling the reward actor
-	//   - Call the cron actor for the current epoch
-	// - If the specified vmheight is higher than the current epoch, apply any
-	//   needed state upgrades to the state
-	// - Apply the specified messages to the state
-	//
-	// The vmheight parameter sets VM execution epoch, and can be used to simulate
-	// message execution in different network versions. If the specified vmheight
-	// epoch is higher than the epoch of the specified tipset, any state upgrades
-	// until the vmheight will be executed on the state before applying messages
-	// specified by the user.
-	//
-	// Note that the initial tipset state computation is not affected by the
-	// vmheight parameter - only the messages in the `apply` set are
-	//
-	// If the caller wants to simply compute the state, vmheight should be set to
-	// the epoch of the specified tipset.
-	//
-	// Messages in the `apply` parameter must have the correct nonces, and gas
-	// values set.
-	StateCompute(context.Context, abi.ChainEpoch, []*types.Message, types.TipSetKey) (*api.ComputeStateOutput, error) //perm:read
-
 	// MethodGroup: Msig
 	// The Msig methods are used to interact with multisig wallets on the
 	// filecoin network
 
-	// MsigGetAvailableBalance returns the portion of a multisig's balance that can be withdrawn or spent
-	MsigGetAvailableBalance(context.Context, address.Address, types.TipSetKey) (types.BigInt, error) //perm:read
-	// MsigGetVestingSchedule returns the vesting details of a given multisig.
-	MsigGetVestingSchedule(context.Context, address.Address, types.TipSetKey) (api.MsigVesting, error) //perm:read
-	// MsigGetVested returns the amount of FIL that vested in a multisig in a certain period.
-	// It takes the following params: <multisig address>, <start epoch>, <end epoch>
-	MsigGetVested(context.Context, address.Address, types.TipSetKey, types.TipSetKey) (types.BigInt, error) //perm:read
-
-	// MsigGetPending returns pending transactions for the given multisig
-	// wallet. Once pending transactions are fully approved, they will no longer
-	// appear here.
-	MsigGetPending(context.Context, address.Address, types.TipSetKey) ([]*api.MsigTransaction, error) //perm:read
-
 	// MsigCreate creates a multisig wallet
 	// It takes the following params: <required number of senders>, <approving addresses>, <unlock duration>
 	//<initial balance>, <sender address of the create msg>, <gas price>
@@ -1001,14 +1025,10 @@ type UnSupport interface {
 	PaychGetWaitReady(context.Context, cid.Cid) (address.Address, error)                                                       //perm:sign
 	PaychAvailableFunds(ctx context.Context, ch address.Address) (*api.ChannelAvailableFunds, error)                           //perm:sign
 	PaychAvailableFundsByFromTo(ctx context.Context, from, to address.Address) (*api.ChannelAvailableFunds, error)             //perm:sign
-	PaychList(context.Context) ([]address.Address, error)                                                                      //perm:read
-	PaychStatus(context.Context, address.Address) (*api.PaychStatus, error)                                                    //perm:read
 	PaychSettle(context.Context, address.Address) (cid.Cid, error)                                                             //perm:sign
 	PaychCollect(context.Context, address.Address) (cid.Cid, error)                                                            //perm:sign
 	PaychAllocateLane(ctx context.Context, ch address.Address) (uint64, error)                                                 //perm:sign
 	PaychNewPayment(ctx context.Context, from, to address.Address, vouchers []api.VoucherSpec) (*api.PaymentInfo, error)       //perm:sign
-	PaychVoucherCheckValid(context.Context, address.Address, *paych.SignedVoucher) error                                       //perm:read
-	PaychVoucherCheckSpendable(context.Context, address.Address, *paych.SignedVoucher, []byte, []byte) (bool, error)           //perm:read
 	PaychVoucherCreate(context.Context, address.Address, types.BigInt, uint64) (*api.VoucherCreateResult, error)               //perm:sign
 	PaychVoucherAdd(context.Context, address.Address, *paych.SignedVoucher, []byte, types.BigInt) (types.BigInt, error)        //perm:write
 	PaychVoucherList(context.Context, address.Address) ([]*paych.SignedVoucher, error)                                         //perm:write
@@ -1029,13 +1049,6 @@ type UnSupport interface {
 	// if supported by the underlying implementation.
 	ChainCheckBlockstore(context.Context) error //perm:admin
 
-	// ChainBlockstoreInfo returns some basic information about the blockstore
-	ChainBlockstoreInfo(context.Context) (map[string]interface{}, error) //perm:read
-
-	NodeStatus(ctx context.Context, inclChainStatus bool) (api.NodeStatus, error) //perm:read
-
-	StateEncodeParams(ctx context.Context, toActCode cid.Cid, method abi.MethodNum, params json.RawMessage) ([]byte, error)
-
 	// v1.14.0
 	// ClientRetrieve(ctx context.Context, params api.RetrievalOrder) (*api.RestrievalRes, error)
 
@@ -1055,7 +1068,4 @@ type UnSupport interface {
 	// ChainHotGC does online (badger) GC on the hot store; only supported if you are using
 	// the splitstore
 	ChainHotGC(ctx context.Context, opts api.HotGCOpts) error //perm:admin
-
-	// RaftState(ctx context.Context) (*api.RaftStateData, error) //perm:read
-	RaftLeader(ctx context.Context) (peer.ID, error) //perm:read
 }
@@ -0,0 +1,779 @@
+package venus
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-bitfield"
+	"github.com/filecoin-project/go-f3/certs"
+	"github.com/filecoin-project/go-f3/gpbft"
+	"github.com/filecoin-project/go-f3/manifest"
+	"github.com/filecoin-project/go-jsonrpc"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/filecoin-project/go-state-types/crypto"
+	"github.com/filecoin-project/go-state-types/dline"
+	"github.com/filecoin-project/go-state-types/network"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/metrics"
+	libnetwork "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+
+	lminer "github.com/filecoin-project/venus/venus-shared/actors/builtin/miner"
+	"github.com/filecoin-project/venus/venus-shared/actors/builtin/verifreg"
+	v1 "github.com/filecoin-project/venus/venus-shared/api/chain/v1"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+var _ v1.FullNode = combined(nil)
+
+type combined interface {
+	Proxy
+	Local
+	UnSupport
+}
+
+// Proxy is a subset of the venus v1.FullNode.
+// Requests involved will be proxied to the chosen remote venus node
+type Proxy interface {
+	// MethodGroup: Account
+
+	StateAccountKey(ctx context.Context, addr address.Address, tsk types.TipSetKey) (address.Address, error) //perm:read
+
+	// MethodGroup: Actor
+
+	StateGetActor(ctx context.Context, actor address.Address, tsk types.TipSetKey) (*types.Actor, error) //perm:read
+	ListActor(ctx context.Context) (map[address.Address]*types.Actor, error)                             //perm:read
+
+	// MethodGroup: ChainInfo
+
+	BlockTime(ctx context.Context) time.Duration                                                                                                                                          //perm:read
+	ChainList(ctx context.Context, tsKey types.TipSetKey, count int) ([]types.TipSetKey, error)                                                                                           //perm:read
+	ChainGetTipSet(ctx context.Context, key types.TipSetKey) (*types.TipSet, error)                                                                                                       //perm:read
+	ChainGetTipSetByHeight(ctx context.Context, height abi.ChainEpoch, tsk types.TipSetKey) (*types.TipSet, error)                                                                        //perm:read
+	ChainGetTipSetAfterHeight(ctx context.Context, height abi.ChainEpoch, tsk types.TipSetKey) (*types.TipSet, error)                                                                     //perm:read
+	StateGetRandomnessFromTickets(ctx context.Context, personalization crypto.DomainSeparationTag, randEpoch abi.ChainEpoch, entropy []byte, tsk types.TipSetKey) (abi.Randomness, error) //perm:read
+	StateGetRandomnessFromBeacon(ctx context.Context, personalization crypto.DomainSeparationTag, randEpoch abi.ChainEpoch, entropy []byte, tsk types.TipSetKey) (abi.Randomness, error)  //perm:read
+
+	// StateGetRandomnessDigestFromTickets is used to sample the chain for randomness.
+	StateGetRandomnessDigestFromTickets(ctx context.Context, randEpoch abi.ChainEpoch, tsk types.TipSetKey) (abi.Randomness, error) //perm:read
+
+	// StateGetRandomnessDigestFromBeacon is used to sample the beacon for randomness.
+	StateGetRandomnessDigestFromBeacon(ctx context.Context, randEpoch abi.ChainEpoch, tsk types.TipSetKey) (abi.Randomness, error) //perm:read
+
+	// StateGetBeaconEntry returns the beacon entry for the given filecoin epoch
+	// by using the recorded entries on the chain. If the entry for the requested
+	// epoch has not yet been produced, the call will block until the entry
+	// becomes available.
+	StateGetBeaconEntry(ctx context.Context, epoch abi.ChainEpoch) (*types.BeaconEntry, error) //perm:read
+
+	ChainGetBlock(ctx context.Context, id cid.Cid) (*types.BlockHeader, error)                                     //perm:read
+	ChainGetMessage(ctx context.Context, msgID cid.Cid) (*types.Message, error)                                    //perm:read
+	ChainGetBlockMessages(ctx context.Context, bid cid.Cid) (*types.BlockMessages, error)                          //perm:read
+	ChainGetMessagesInTipset(ctx context.Context, key types.TipSetKey) ([]types.MessageCID, error)                 //perm:read
+	ChainGetReceipts(ctx context.Context, id cid.Cid) ([]types.MessageReceipt, error)                              //perm:read
+	ChainGetParentMessages(ctx context.Context, bcid cid.Cid) ([]types.MessageCID, error)                          //perm:read
+	ChainGetParentReceipts(ctx context.Context, bcid cid.Cid) ([]*types.MessageReceipt, error)                     //perm:read
+	StateVerifiedRegistryRootKey(ctx context.Context, tsk types.TipSetKey) (address.Address, error)                //perm:read
+	StateVerifierStatus(ctx context.Context, addr address.Address, tsk types.TipSetKey) (*abi.StoragePower, error) //perm:read
+	GetFullBlock(ctx context.Context, id cid.Cid) (*types.FullBlock, error)                                        //perm:read
+	GetActor(ctx context.Context, addr address.Address) (*types.Actor, error)                                      //perm:read
+	GetParentStateRootActor(ctx context.Context, ts *types.TipSet, addr address.Address) (*types.Actor, error)     //perm:read
+	GetEntry(ctx context.Context, height abi.ChainEpoch, round uint64) (*types.BeaconEntry, error)                 //perm:read
+	ProtocolParameters(ctx context.Context) (*types.ProtocolParams, error)                                         //perm:read
+	ResolveToKeyAddr(ctx context.Context, addr address.Address, ts *types.TipSet) (address.Address, error)         //perm:read
+	StateNetworkName(ctx context.Context) (types.NetworkName, error)                                               //perm:read
+	StateNetworkVersion(ctx context.Context, tsk types.TipSetKey) (network.Version, error)                         //perm:read
+	ChainExport(context.Context, abi.ChainEpoch, bool, types.TipSetKey) (<-chan []byte, error)                     //perm:read
+	ChainGetPath(ctx context.Context, from types.TipSetKey, to types.TipSetKey) ([]*types.HeadChange, error)       //perm:read
+
+	// StateGetNetworkParams return current network params
+	StateGetNetworkParams(ctx context.Context) (*types.NetworkParams, error) //perm:read
+
+	// StateActorCodeCIDs returns the CIDs of all the builtin actors for the given network version
+	StateActorCodeCIDs(context.Context, network.Version) (map[string]cid.Cid, error) //perm:read
+
+	// ChainGetGenesis returns the genesis tipset.
+	ChainGetGenesis(context.Context) (*types.TipSet, error) //perm:read
+
+	// StateActorManifestCID returns the CID of the builtin actors manifest for the given network version
+	StateActorManifestCID(context.Context, network.Version) (cid.Cid, error) //perm:read
+
+	StateCall(ctx context.Context, msg *types.Message, tsk types.TipSetKey) (*types.InvocResult, error) //perm:read
+	StateReplay(context.Context, types.TipSetKey, cid.Cid) (*types.InvocResult, error)                  //perm:read
+
+	// ChainGetEvents returns the events under an event AMT root CID.
+	ChainGetEvents(context.Context, cid.Cid) ([]types.Event, error) //perm:read
+
+	// StateCompute is a flexible command that applies the given messages on the given tipset.
+	// The messages are run as though the VM were at the provided height.
+	//
+	// When called, StateCompute will:
+	// - Load the provided tipset, or use the current chain head if not provided
+	// - Compute the tipset state of the provided tipset on top of the parent state
+	//   - (note that this step runs before vmheight is applied to the execution)
+	//   - Execute state upgrade if any were scheduled at the epoch, or in null
+	//     blocks preceding the tipset
+	//   - Call the cron actor on null blocks preceding the tipset
+	//   - For each block in the tipset
+	//     - Apply messages in blocks in the specified
+	//     - Award block reward by calling the reward actor
+	//   - Call the cron actor for the current epoch
+	// - If the specified vmheight is higher than the current epoch, apply any
+	//   needed state upgrades to the state
+	// - Apply the specified messages to the state
+	//
+	// The vmheight parameter sets VM execution epoch, and can be used to simulate
+	// message execution in different network versions. If the specified vmheight
+	// epoch is higher than the epoch of the specified tipset, any state upgrades
+	// until the vmheight will be executed on the state before applying messages
+	// specified by the user.
+	//
+	// Note that the initial tipset state computation is not affected by the
+	// vmheight parameter - only the messages in the `apply` set are
+	//
+	// If the caller wants to simply compute the state, vmheight should be set to
+	// the epoch of the specified tipset.
+	//
+	// Messages in the `apply` parameter must have the correct nonces, and gas
+	// values set.
+	StateCompute(context.Context, abi.ChainEpoch, []*types.Message, types.TipSetKey) (*types.ComputeStateOutput, error) //perm:read
+
+	// StateMarketProposalPending returns whether a given proposal CID is marked as pending in the market actor
+	StateMarketProposalPending(ctx context.Context, proposalCid cid.Cid, tsk types.TipSetKey) (bool, error) //perm:read
+
+	// MethodGroup: MinerState
+
+	StateReadState(ctx context.Context, actor address.Address, tsk types.TipSetKey) (*types.ActorState, error)                     //perm:read
+	StateListMessages(ctx context.Context, match *types.MessageMatch, tsk types.TipSetKey, toht abi.ChainEpoch) ([]cid.Cid, error) //perm:read
+	StateMinerSectorAllocated(ctx context.Context, maddr address.Address, s abi.SectorNumber, tsk types.TipSetKey) (bool, error)   //perm:read
+
+	// StateSectorPreCommitInfo returns the PreCommit info for the specified miner's sector.
+	// Returns nil and no error if the sector isn't precommitted.
+	//
+	// Note that the sector number may be allocated while PreCommitInfo is nil. This means that either allocated sector
+	// numbers were compacted, and the sector number was marked as allocated in order to reduce size of the allocated
+	// sectors bitfield, or that the sector was precommitted, but the precommit has expired.
+	StateSectorPreCommitInfo(ctx context.Context, maddr address.Address, n abi.SectorNumber, tsk types.TipSetKey) (*types.SectorPreCommitOnChainInfo, error) //perm:read
+
+	StateSectorGetInfo(ctx context.Context, maddr address.Address, n abi.SectorNumber, tsk types.TipSetKey) (*lminer.SectorOnChainInfo, error)            //perm:read
+	StateSectorPartition(ctx context.Context, maddr address.Address, sectorNumber abi.SectorNumber, tsk types.TipSetKey) (*lminer.SectorLocation, error)  //perm:read
+	StateMinerSectorSize(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (abi.SectorSize, error)                                         //perm:read
+	StateMinerInfo(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (types.MinerInfo, error)                                              //perm:read
+	StateMinerWorkerAddress(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (address.Address, error)                                     //perm:read
+	StateMinerFaults(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (bitfield.BitField, error)                                          //perm:read
+	StateAllMinerFaults(ctx context.Context, lookback abi.ChainEpoch, ts types.TipSetKey) ([]*types.Fault, error)                                         //perm:read
+	StateMinerRecoveries(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (bitfield.BitField, error)                                      //perm:read
+	StateMinerProvingDeadline(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (*dline.Info, error)                                       //perm:read
+	StateMinerPartitions(ctx context.Context, maddr address.Address, dlIdx uint64, tsk types.TipSetKey) ([]types.Partition, error)                        //perm:read
+	StateMinerDeadlines(ctx context.Context, maddr address.Address, tsk types.TipSetKey) ([]types.Deadline, error)                                        //perm:read
+	StateMinerSectors(ctx context.Context, maddr address.Address, sectorNos *bitfield.BitField, tsk types.TipSetKey) ([]*lminer.SectorOnChainInfo, error) //perm:read
+	StateMarketStorageDeal(ctx context.Context, dealID abi.DealID, tsk types.TipSetKey) (*types.MarketDeal, error)                                        //perm:read
+
+	// StateGetAllocationForPendingDeal returns the allocation for a given deal ID of a pending deal. Returns nil if
+	// pending allocation is not found.
+	StateGetAllocationForPendingDeal(ctx context.Context, dealID abi.DealID, tsk types.TipSetKey) (*verifreg.Allocation, error) //perm:read
+
+	// StateGetAllocationIdForPendingDeal is like StateGetAllocationForPendingDeal except it returns the allocation ID
+	StateGetAllocationIdForPendingDeal(ctx context.Context, dealID abi.DealID, tsk types.TipSetKey) (verifreg.AllocationId, error) //perm:read
+
+	// StateGetAllocation returns the allocation for a given address and allocation ID.
+	StateGetAllocation(ctx context.Context, clientAddr address.Address, allocationID verifreg.AllocationId, tsk types.TipSetKey) (*verifreg.Allocation, error) //perm:read
+
+	// StateGetAllAllocations returns the all the allocations available in verified registry actor.
+	StateGetAllAllocations(ctx context.Context, tsk types.TipSetKey) (map[verifreg.AllocationId]verifreg.Allocation, error) //perm:read
+
+	// StateGetAllocations returns the all the allocations for a given client.
+	StateGetAllocations(ctx context.Context, clientAddr address.Address, tsk types.TipSetKey) (map[verifreg.AllocationId]verifreg.Allocation, error) //perm:read
+
+	// StateGetClaim returns the claim for a given address and claim ID.
+	StateGetClaim(ctx context.Context, providerAddr address.Address, claimID verifreg.ClaimId, tsk types.TipSetKey) (*verifreg.Claim, error) //perm:read
+
+	// StateGetClaims returns the all the claims for a given provider.
+	StateGetClaims(ctx context.Context, providerAddr address.Address, tsk types.TipSetKey) (map[verifreg.ClaimId]verifreg.Claim, error) //perm:read
+
+	// StateGetAllClaims returns the all the claims available in verified registry actor.
+	StateGetAllClaims(ctx context.Context, tsk types.TipSetKey) (map[verifreg.ClaimId]verifreg.Claim, error) //perm:read
+
+	// StateComputeDataCID computes DataCID from a set of on-chain deals
+	StateComputeDataCID(ctx context.Context, maddr address.Address, sectorType abi.RegisteredSealProof, deals []abi.DealID, tsk types.TipSetKey) (cid.Cid, error) //perm:read
+
+	StateMinerPreCommitDepositForPower(ctx context.Context, maddr address.Address, pci types.SectorPreCommitInfo, tsk types.TipSetKey) (big.Int, error) //perm:read
+
+	// StateMinerInitialPledgeCollateral attempts to calculate the initial pledge collateral based on a SectorPreCommitInfo.
+	// This method uses the DealIDs field in SectorPreCommitInfo to determine the amount of verified
+	// deal space in the sector in order to perform a QAP calculation. Since network version 22 and
+	// the introduction of DDO, the DealIDs field can no longer be used to reliably determine verified
+	// deal space; therefore, this method is deprecated. Use StateMinerInitialPledgeForSector instead
+	// and pass in the verified deal space directly.
+	//
+	// Deprecated: Use StateMinerInitialPledgeForSector instead.
+	StateMinerInitialPledgeCollateral(ctx context.Context, maddr address.Address, pci types.SectorPreCommitInfo, tsk types.TipSetKey) (big.Int, error) //perm:read
+
+	// StateMinerInitialPledgeForSector returns the initial pledge collateral for a given sector
+	// duration, size, and combined size of any verified pieces within the sector. This calculation
+	// depends on current network conditions (total power, total pledge and current rewards) at the
+	// given tipset.
+	StateMinerInitialPledgeForSector(ctx context.Context, sectorDuration abi.ChainEpoch, sectorSize abi.SectorSize, verifiedSize uint64, tsk types.TipSetKey) (types.BigInt, error) //perm:read
+
+	// StateMinerCreationDeposit calculates the deposit required for creating a new miner
+	// according to FIP-0077 specification. This deposit is based on the network's current
+	// economic parameters including circulating supply, network power, and pledge collateral.
+	//
+	// See: node/impl/full/state.go StateMinerCreationDeposit implementation.
+	StateMinerCreationDeposit(ctx context.Context, tsk types.TipSetKey) (types.BigInt, error) //perm:read
+
+	StateVMCirculatingSupplyInternal(ctx context.Context, tsk types.TipSetKey) (types.CirculatingSupply, error)                   //perm:read
+	StateCirculatingSupply(ctx context.Context, tsk types.TipSetKey) (abi.TokenAmount, error)                                     //perm:read
+	StateMarketDeals(ctx context.Context, tsk types.TipSetKey) (map[string]*types.MarketDeal, error)                              //perm:read
+	StateMinerActiveSectors(ctx context.Context, maddr address.Address, tsk types.TipSetKey) ([]*lminer.SectorOnChainInfo, error) //perm:read
+	StateLookupID(ctx context.Context, addr address.Address, tsk types.TipSetKey) (address.Address, error)                        //perm:read
+
+	// StateLookupRobustAddress returns the public key address of the given ID address for non-account addresses (multisig, miners etc)
+	StateLookupRobustAddress(context.Context, address.Address, types.TipSetKey) (address.Address, error) //perm:read
+
+	StateListMiners(ctx context.Context, tsk types.TipSetKey) ([]address.Address, error)                                                                     //perm:read
+	StateListActors(ctx context.Context, tsk types.TipSetKey) ([]address.Address, error)                                                                     //perm:read
+	StateMinerPower(ctx context.Context, addr address.Address, tsk types.TipSetKey) (*types.MinerPower, error)                                               //perm:read
+	StateMinerAvailableBalance(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (big.Int, error)                                             //perm:read
+	StateSectorExpiration(ctx context.Context, maddr address.Address, sectorNumber abi.SectorNumber, tsk types.TipSetKey) (*lminer.SectorExpiration, error)  //perm:read
+	StateChangedActors(context.Context, cid.Cid, cid.Cid) (map[string]types.Actor, error)                                                                    //perm:read
+	StateMinerSectorCount(ctx context.Context, addr address.Address, tsk types.TipSetKey) (types.MinerSectors, error)                                        //perm:read
+	StateMarketBalance(ctx context.Context, addr address.Address, tsk types.TipSetKey) (types.MarketBalance, error)                                          //perm:read
+	StateDealProviderCollateralBounds(ctx context.Context, size abi.PaddedPieceSize, verified bool, tsk types.TipSetKey) (types.DealCollateralBounds, error) //perm:read
+	StateVerifiedClientStatus(ctx context.Context, addr address.Address, tsk types.TipSetKey) (*abi.StoragePower, error)                                     //perm:read
+
+	// StateMinerAllocated returns a bitfield containing all sector numbers marked as allocated in miner state
+	StateMinerAllocated(context.Context, address.Address, types.TipSetKey) (*bitfield.BitField, error) //perm:read
+
+	// MethodGroup: BlockStore
+
+	ChainReadObj(ctx context.Context, cid cid.Cid) ([]byte, error)                      //perm:read
+	ChainHasObj(ctx context.Context, obj cid.Cid) (bool, error)                         //perm:read
+	ChainStatObj(ctx context.Context, obj cid.Cid, base cid.Cid) (types.ObjStat, error) //perm:read
+
+	// MethodGroup: Market
+
+	StateMarketParticipants(ctx context.Context, tsk types.TipSetKey) (map[string]types.MarketBalance, error) //perm:read
+
+	// MethodGroup: Mining
+
+	MinerGetBaseInfo(ctx context.Context, maddr address.Address, round abi.ChainEpoch, tsk types.TipSetKey) (*types.MiningBaseInfo, error) //perm:read
+	MinerCreateBlock(ctx context.Context, bt *types.BlockTemplate) (*types.BlockMsg, error)                                                //perm:write
+
+	// MethodGroup: MessagePool
+
+	MpoolPublishByAddr(context.Context, address.Address) error                                                           //perm:write
+	MpoolPublishMessage(ctx context.Context, smsg *types.SignedMessage) error                                            //perm:write
+	MpoolPush(ctx context.Context, smsg *types.SignedMessage) (cid.Cid, error)                                           //perm:write
+	MpoolGetConfig(context.Context) (*types.MpoolConfig, error)                                                          //perm:read
+	MpoolSelect(context.Context, types.TipSetKey, float64) ([]*types.SignedMessage, error)                               //perm:read
+	MpoolSelects(context.Context, types.TipSetKey, []float64) ([][]*types.SignedMessage, error)                          //perm:read
+	MpoolPending(ctx context.Context, tsk types.TipSetKey) ([]*types.SignedMessage, error)                               //perm:read
+	MpoolPushMessage(ctx context.Context, msg *types.Message, spec *types.MessageSendSpec) (*types.SignedMessage, error) //perm:sign
+	MpoolBatchPush(ctx context.Context, smsgs []*types.SignedMessage) ([]cid.Cid, error)                                 //perm:write
+	MpoolBatchPushUntrusted(ctx context.Context, smsgs []*types.SignedMessage) ([]cid.Cid, error)                        //perm:write
+	MpoolGetNonce(ctx context.Context, addr address.Address) (uint64, error)                                             //perm:read
+	MpoolSub(ctx context.Context) (<-chan types.MpoolUpdate, error)                                                      //perm:read
+	GasEstimateGasLimit(ctx context.Context, msgIn *types.Message, tsk types.TipSetKey) (int64, error)                   //perm:read
+
+	// MpoolCheckMessages performs logical checks on a batch of messages
+	MpoolCheckMessages(ctx context.Context, protos []*types.MessagePrototype) ([][]types.MessageCheckStatus, error) //perm:read
+
+	// MpoolCheckPendingMessages performs logical checks for all pending messages from a given address
+	MpoolCheckPendingMessages(ctx context.Context, addr address.Address) ([][]types.MessageCheckStatus, error) //perm:read
+
+	// MpoolCheckReplaceMessages performs logical checks on pending messages with replacement
+	MpoolCheckReplaceMessages(ctx context.Context, msg []*types.Message) ([][]types.MessageCheckStatus, error) //perm:read
+
+	// MethodGroup: Network
+
+	NetAddrsListen(ctx context.Context) (peer.AddrInfo, error) //perm:read
+	NetProtectAdd(ctx context.Context, acl []peer.ID) error    //perm:admin
+
+	// MethodGroup: Paychan
+
+	// PaychList list the addresses of all channels that have been created
+	PaychList(ctx context.Context) ([]address.Address, error) //perm:read
+
+	// PaychStatus get the payment channel status
+	// @pch: payment channel address
+	PaychStatus(ctx context.Context, pch address.Address) (*types.Status, error) //perm:read
+
+	// PaychVoucherCheckValid checks if the given voucher is valid (is or could become spendable at some point).
+	// If the channel is not in the store, fetches the channel from state (and checks that
+	// the channel To address is owned by the wallet).
+	// @pch: payment channel address
+	// @sv: voucher
+	PaychVoucherCheckValid(ctx context.Context, ch address.Address, sv *types.SignedVoucher) error //perm:read
+
+	// PaychVoucherCheckSpendable checks if the given voucher is currently spendable
+	// @pch: payment channel address
+	// @sv: voucher
+	PaychVoucherCheckSpendable(ctx context.Context, ch address.Address, sv *types.SignedVoucher, secret []byte, proof []byte) (bool, error) //perm:read
+
+	// MethodGroup: Syncer
+
+	ChainTipSetWeight(ctx context.Context, tsk types.TipSetKey) (big.Int, error) //perm:read
+	SyncSubmitBlock(ctx context.Context, blk *types.BlockMsg) error              //perm:write
+	SyncState(ctx context.Context) (*types.SyncState, error)                     //perm:read
+
+	// SyncIncomingBlocks returns a channel streaming incoming, potentially not
+	// yet synced block headers.
+	SyncIncomingBlocks(ctx context.Context) (<-chan *types.BlockHeader, error) //perm:read
+
+	// MethodGroup: Wallet
+
+	WalletSign(ctx context.Context, k address.Address, msg []byte, meta types.MsgMeta) (*crypto.Signature, error) //perm:sign
+	WalletHas(ctx context.Context, addr address.Address) (bool, error)                                            //perm:write
+	WalletBalance(ctx context.Context, addr address.Address) (abi.TokenAmount, error)                             //perm:read
+
+	// MethodGroup: Common
+
+	// Version provides information about API provider
+	Version(ctx context.Context) (types.Version, error) //perm:read
+
+	NodeStatus(ctx context.Context, inclChainStatus bool) (types.NodeStatus, error) //perm:read
+
+	// MethodGroup: ETH
+
+	// These methods are used for Ethereum-compatible JSON-RPC calls
+	//
+	// EthAccounts will always return [] since we don't expect Lotus to manage private keys
+	EthAccounts(ctx context.Context) ([]types.EthAddress, error) //perm:read
+
+	// EthAddressToFilecoinAddress converts an EthAddress into an f410 Filecoin Address
+	EthAddressToFilecoinAddress(ctx context.Context, ethAddress types.EthAddress) (address.Address, error) //perm:read
+
+	// FilecoinAddressToEthAddress converts an f410 or f0 Filecoin Address to an EthAddress
+	FilecoinAddressToEthAddress(ctx context.Context, filecoinAddress address.Address) (types.EthAddress, error) //perm:read
+
+	// EthBlockNumber returns the height of the latest (heaviest) TipSet
+	EthBlockNumber(ctx context.Context) (types.EthUint64, error) //perm:read
+
+	// EthGetBlockTransactionCountByNumber returns the number of messages in the TipSet
+	EthGetBlockTransactionCountByNumber(ctx context.Context, blkNum types.EthUint64) (types.EthUint64, error) //perm:read
+
+	// EthGetBlockTransactionCountByHash returns the number of messages in the TipSet
+	EthGetBlockTransactionCountByHash(ctx context.Context, blkHash types.EthHash) (types.EthUint64, error) //perm:read
+
+	EthGetBlockByHash(ctx context.Context, blkHash types.EthHash, fullTxInfo bool) (types.EthBlock, error)                                               //perm:read
+	EthGetBlockByNumber(ctx context.Context, blkNum string, fullTxInfo bool) (types.EthBlock, error)                                                     //perm:read
+	EthGetTransactionByHash(ctx context.Context, txHash *types.EthHash) (*types.EthTx, error)                                                            //perm:read
+	EthGetTransactionByHashLimited(ctx context.Context, txHash *types.EthHash, limit abi.ChainEpoch) (*types.EthTx, error)                               //perm:read
+	EthGetTransactionHashByCid(ctx context.Context, cid cid.Cid) (*types.EthHash, error)                                                                 //perm:read
+	EthGetMessageCidByTransactionHash(ctx context.Context, txHash *types.EthHash) (*cid.Cid, error)                                                      //perm:read
+	EthGetTransactionCount(ctx context.Context, sender types.EthAddress, blkParam types.EthBlockNumberOrHash) (types.EthUint64, error)                   //perm:read
+	EthGetBlockReceipts(ctx context.Context, blkParam types.EthBlockNumberOrHash) ([]*types.EthTxReceipt, error)                                         //perm:read
+	EthGetBlockReceiptsLimited(ctx context.Context, blkParam types.EthBlockNumberOrHash, limit abi.ChainEpoch) ([]*types.EthTxReceipt, error)            //perm:read
+	EthGetTransactionReceipt(ctx context.Context, txHash types.EthHash) (*types.EthTxReceipt, error)                                                     //perm:read
+	EthGetTransactionReceiptLimited(ctx context.Context, txHash types.EthHash, limit abi.ChainEpoch) (*types.EthTxReceipt, error)                        //perm:read
+	EthGetTransactionByBlockHashAndIndex(ctx context.Context, blkHash types.EthHash, txIndex types.EthUint64) (types.EthTx, error)                       //perm:read
+	EthGetTransactionByBlockNumberAndIndex(ctx context.Context, blkNum types.EthUint64, txIndex types.EthUint64) (types.EthTx, error)                    //perm:read
+	EthGetCode(ctx context.Context, address types.EthAddress, blkParam types.EthBlockNumberOrHash) (types.EthBytes, error)                               //perm:read
+	EthGetStorageAt(ctx context.Context, address types.EthAddress, position types.EthBytes, blkParam types.EthBlockNumberOrHash) (types.EthBytes, error) //perm:read
+	EthGetBalance(ctx context.Context, address types.EthAddress, blkParam types.EthBlockNumberOrHash) (types.EthBigInt, error)                           //perm:read
+	EthChainId(ctx context.Context) (types.EthUint64, error)                                                                                             //perm:read
+	EthSyncing(ctx context.Context) (types.EthSyncingResult, error)                                                                                      //perm:read
+	NetVersion(ctx context.Context) (string, error)                                                                                                      //perm:read
+	NetListening(ctx context.Context) (bool, error)                                                                                                      //perm:read
+	EthProtocolVersion(ctx context.Context) (types.EthUint64, error)                                                                                     //perm:read
+	EthGasPrice(ctx context.Context) (types.EthBigInt, error)                                                                                            //perm:read
+	EthFeeHistory(ctx context.Context, p jsonrpc.RawParams) (types.EthFeeHistory, error)                                                                 //perm:read
+	EthMaxPriorityFeePerGas(ctx context.Context) (types.EthBigInt, error)                                                                                //perm:read
+	EthEstimateGas(ctx context.Context, p jsonrpc.RawParams) (types.EthUint64, error)                                                                    //perm:read
+	EthCall(ctx context.Context, tx types.EthCall, blkParam types.EthBlockNumberOrHash) (types.EthBytes, error)                                          //perm:read
+	EthSendRawTransaction(ctx context.Context, rawTx types.EthBytes) (types.EthHash, error)                                                              //perm:read
+
+	// Returns the client version
+	Web3ClientVersion(ctx context.Context) (string, error) //perm:read
+
+	// Returns an OpenEthereum-compatible trace of the given block (implementing `trace_block`),
+	// translating Filecoin semantics into Ethereum semantics and tracing both EVM and FVM calls.
+	//
+	// Features:
+	//
+	// - FVM actor create events, calls, etc. show up as if they were EVM smart contract events.
+	// - Native FVM call inputs are ABI-encoded (Solidity ABI) as if they were calls to a
+	//   `handle_filecoin_method(uint64 method, uint64 codec, bytes params)` function
+	//   (where `codec` is the IPLD codec of `params`).
+	// - Native FVM call outputs (return values) are ABI-encoded as `(uint32 exit_code, uint64
+	//   codec, bytes output)` where `codec` is the IPLD codec of `output`.
+	//
+	// Limitations (for now):
+	//
+	// 1. Block rewards are not included in the trace.
+	// 2. SELFDESTRUCT operations are not included in the trace.
+	// 3. EVM smart contract "create" events always specify `0xfe` as the "code" for newly created EVM smart contracts.
+	EthTraceBlock(ctx context.Context, blkNum string) ([]*types.EthTraceBlock, error) //perm:read
+
+	// Replays all transactions in a block returning the requested traces for each transaction
+	EthTraceReplayBlockTransactions(ctx context.Context, blkNum string, traceTypes []string) ([]*types.EthTraceReplayBlockTransaction, error) //perm:read
+
+	// Implmements OpenEthereum-compatible API method trace_transaction
+	EthTraceTransaction(ctx context.Context, txHash string) ([]*types.EthTraceTransaction, error) //perm:read
+
+	// Implements OpenEthereum-compatible API method trace_filter
+	EthTraceFilter(ctx context.Context, filter types.EthTraceFilterCriteria) ([]*types.EthTraceFilterResult, error) //perm:read
+
+	// MethodGroup: ETHEvent
+
+	// Returns event logs matching given filter spec.
+	EthGetLogs(ctx context.Context, filter *types.EthFilterSpec) (*types.EthFilterResult, error) //perm:read
+
+	// Polling method for a filter, returns event logs which occurred since last poll.
+	// (requires write perm since timestamp of last filter execution will be written)
+	EthGetFilterChanges(ctx context.Context, id types.EthFilterID) (*types.EthFilterResult, error) //perm:read
+
+	// Returns event logs matching filter with given id.
+	// (requires write perm since timestamp of last filter execution will be written)
+	EthGetFilterLogs(ctx context.Context, id types.EthFilterID) (*types.EthFilterResult, error) //perm:read
+
+	// Installs a persistent filter based on given filter spec.
+	EthNewFilter(ctx context.Context, filter *types.EthFilterSpec) (types.EthFilterID, error) //perm:read
+
+	// Installs a persistent filter to notify when a new block arrives.
+	EthNewBlockFilter(ctx context.Context) (types.EthFilterID, error) //perm:read
+
+	// Installs a persistent filter to notify when new messages arrive in the message pool.
+	EthNewPendingTransactionFilter(ctx context.Context) (types.EthFilterID, error) //perm:read
+
+	// Uninstalls a filter with given id.
+	EthUninstallFilter(ctx context.Context, id types.EthFilterID) (bool, error) //perm:read
+
+	// Subscribe to different event types using websockets
+	// eventTypes is one or more of:
+	//  - newHeads: notify when new blocks arrive.
+	//  - pendingTransactions: notify when new messages arrive in the message pool.
+	//  - logs: notify new event logs that match a criteria
+	// params contains additional parameters used with the log event type
+	// The client will receive a stream of EthSubscriptionResponse values until EthUnsubscribe is called.
+	EthSubscribe(ctx context.Context, params jsonrpc.RawParams) (types.EthSubscriptionID, error) //perm:read
+
+	// Unsubscribe from a websocket subscription
+	EthUnsubscribe(ctx context.Context, id types.EthSubscriptionID) (bool, error) //perm:read
+
+	// MethodGroup: ActorEvent
+
+	// GetActorEventsRaw returns all user-programmed and built-in actor events that match the given
+	// filter.
+	// This is a request/response API.
+	// Results available from this API may be limited by the MaxFilterResults and MaxFilterHeightRange
+	// configuration options and also the amount of historical data available in the node.
+	//
+	// This is an EXPERIMENTAL API and may be subject to change.
+	GetActorEventsRaw(ctx context.Context, filter *types.ActorEventFilter) ([]*types.ActorEvent, error) //perm:read
+
+	// SubscribeActorEventsRaw returns a long-lived stream of all user-programmed and built-in actor
+	// events that match the given filter.
+	// Events that match the given filter are written to the stream in real-time as they are emitted
+	// from the FVM.
+	// The response stream is closed when the client disconnects, when a ToHeight is specified and is
+	// reached, or if there is an error while writing an event to the stream.
+	// This API also allows clients to read all historical events matching the given filter before any
+	// real-time events are written to the response stream if the filter specifies an earlier
+	// FromHeight.
+	// Results available from this API may be limited by the MaxFilterResults and MaxFilterHeightRange
+	// configuration options and also the amount of historical data available in the node.
+	//
+	// Note: this API is only available via websocket connections.
+	// This is an EXPERIMENTAL API and may be subject to change.
+	SubscribeActorEventsRaw(ctx context.Context, filter *types.ActorEventFilter) (<-chan *types.ActorEvent, error) //perm:read
+
+	// MethodGroup: F3
+
+	// F3GetOrRenewParticipationTicket retrieves or renews a participation ticket
+	// necessary for a miner to engage in the F3 consensus process for the given
+	// number of instances.
+	//
+	// This function accepts an optional previous ticket. If provided, a new ticket
+	// will be issued only under one the following conditions:
+	//   1. The previous ticket has expired.
+	//   2. The issuer of the previous ticket matches the node processing this
+	//      request.
+	//
+	// If there is an issuer mismatch (ErrF3ParticipationIssuerMismatch), the miner
+	// must retry obtaining a new ticket to ensure it is only participating in one F3
+	// instance at any time. If the number of instances is beyond the maximum leasable
+	// participation instances accepted by the node ErrF3ParticipationTooManyInstances
+	// is returned.
+	//
+	// Note: Successfully acquiring a ticket alone does not constitute participation.
+	// The retrieved ticket must be used to invoke F3Participate to actively engage
+	// in the F3 consensus process.
+	F3GetOrRenewParticipationTicket(ctx context.Context, minerID address.Address, previous types.F3ParticipationTicket, instances uint64) (types.F3ParticipationTicket, error) //perm:sign
+
+	// F3Participate enrolls a storage provider in the F3 consensus process using a
+	// provided participation ticket. This ticket grants a temporary lease that enables
+	// the provider to sign transactions as part of the F3 consensus.
+	//
+	// The function verifies the ticket's validity and checks if the ticket's issuer
+	// aligns with the current node. If there is an issuer mismatch
+	// (ErrF3ParticipationIssuerMismatch), the provider should retry with the same
+	// ticket, assuming the issue is due to transient network problems or operational
+	// deployment conditions. If the ticket is invalid
+	// (ErrF3ParticipationTicketInvalid) or has expired
+	// (ErrF3ParticipationTicketExpired), the provider must obtain a new ticket by
+	// calling F3GetOrRenewParticipationTicket.
+	//
+	// The start instance associated to the given ticket cannot be less than the
+	// start instance of any existing lease held by the miner. Otherwise,
+	// ErrF3ParticipationTicketStartBeforeExisting is returned. In this case, the
+	// miner should acquire a new ticket before attempting to participate again.
+	//
+	// For details on obtaining or renewing a ticket, see F3GetOrRenewParticipationTicket.
+	F3Participate(ctx context.Context, ticket types.F3ParticipationTicket) (types.F3ParticipationLease, error) //perm:sign
+
+	// F3GetCertificate returns a finality certificate at given instance number
+	F3GetCertificate(ctx context.Context, instance uint64) (*certs.FinalityCertificate, error) //perm:read
+
+	// F3GetLatestCertificate returns the latest finality certificate
+	F3GetLatestCertificate(ctx context.Context) (*certs.FinalityCertificate, error) //perm:read
+
+	// F3GetECPowerTable returns a F3 specific power table for use in standalone F3 nodes.
+	F3GetECPowerTable(ctx context.Context, tsk types.TipSetKey) (gpbft.PowerEntries, error) //perm:read
+
+	// F3GetF3PowerTable returns a F3 specific power table.
+	F3GetF3PowerTable(ctx context.Context, tsk types.TipSetKey) (gpbft.PowerEntries, error) //perm:read
+
+	// F3GetManifest returns the current manifest being used for F3
+	F3GetManifest(ctx context.Context) (*manifest.Manifest, error) //perm:read
+
+	// F3GetPowerTableByInstance returns the power table (committee) used to validate the specified instance.
+	F3GetPowerTableByInstance(ctx context.Context, instance uint64) (gpbft.PowerEntries, error) //perm:read
+
+	// F3IsRunning returns true if the F3 instance is running, false if it's not running but
+	// it's enabled, and an error when disabled entirely.
+	F3IsRunning(ctx context.Context) (bool, error) //perm:read
+
+	// F3GetProgress returns the progress of the current F3 instance in terms of instance ID, round and phase.
+	F3GetProgress(ctx context.Context) (gpbft.InstanceProgress, error) //perm:read
+
+	// F3ListParticipants returns the list of miners that are currently participating in F3 via this node.
+	F3ListParticipants(ctx context.Context) ([]types.F3Participant, error) //perm:read
+}
+
+// Local is a subset of the venus v1.FullNode.
+// Requests will be handled locally
+type Local interface {
+	// MethodGroup: ChainInfo
+
+	ChainHead(ctx context.Context) (*types.TipSet, error)                //perm:read
+	ChainNotify(ctx context.Context) (<-chan []*types.HeadChange, error) //perm:read
+
+	// StateSearchMsg looks back up to limit epochs in the chain for a message, and returns its receipt and the tipset where it was executed
+	//
+	// NOTE: If a replacing message is found on chain, this method will return
+	// a MsgLookup for the replacing message - the MsgLookup.Message will be a different
+	// CID than the one provided in the 'cid' param, MsgLookup.Receipt will contain the
+	// result of the execution of the replacing message.
+	//
+	// If the caller wants to ensure that exactly the requested message was executed,
+	// they must check that MsgLookup.Message is equal to the provided 'cid', or set the
+	// `allowReplaced` parameter to false. Without this check, and with `allowReplaced`
+	// set to true, both the requested and original message may appear as
+	// successfully executed on-chain, which may look like a double-spend.
+	//
+	// A replacing message is a message with a different CID, any of Gas values, and
+	// different signature, but with all other parameters matching (source/destination,
+	// nonce, params, etc.)
+	StateSearchMsg(ctx context.Context, from types.TipSetKey, msg cid.Cid, limit abi.ChainEpoch, allowReplaced bool) (*types.MsgLookup, error) //perm:read
+
+	// StateWaitMsg looks back up to limit epochs in the chain for a message.
+	// If not found, it blocks until the message arrives on chain, and gets to the
+	// indicated confidence depth.
+	//
+	// NOTE: If a replacing message is found on chain, this method will return
+	// a MsgLookup for the replacing message - the MsgLookup.Message will be a different
+	// CID than the one provided in the 'cid' param, MsgLookup.Receipt will contain the
+	// result of the execution of the replacing message.
+	//
+	// If the caller wants to ensure that exactly the requested message was executed,
+	// they must check that MsgLookup.Message is equal to the provided 'cid', or set the
+	// `allowReplaced` parameter to false. Without this check, and with `allowReplaced`
+	// set to true, both the requested and original message may appear as
+	// successfully executed on-chain, which may look like a double-spend.
+	//
+	// A replacing message is a message with a different CID, any of Gas values, and
+	// different signature, but with all other parameters matching (source/destination,
+	// nonce, params, etc.)
+	StateWaitMsg(ctx context.Context, cid cid.Cid, confidence uint64, limit abi.ChainEpoch, allowReplaced bool) (*types.MsgLookup, error) //perm:read
+
+	// MethodGroup: MinerState
+
+	StateDecodeParams(ctx context.Context, toAddr address.Address, method abi.MethodNum, params []byte, tsk types.TipSetKey) (interface{}, error) //perm:read
+	StateEncodeParams(ctx context.Context, toActCode cid.Cid, method abi.MethodNum, params json.RawMessage) ([]byte, error)                       //perm:read
+
+	// MethodGroup: MessagePool
+
+	GasEstimateMessageGas(ctx context.Context, msg *types.Message, spec *types.MessageSendSpec, tsk types.TipSetKey) (*types.Message, error)                           //perm:read
+	GasBatchEstimateMessageGas(ctx context.Context, estimateMessages []*types.EstimateMessage, fromNonce uint64, tsk types.TipSetKey) ([]*types.EstimateResult, error) //perm:read
+	GasEstimateFeeCap(ctx context.Context, msg *types.Message, maxqueueblks int64, tsk types.TipSetKey) (big.Int, error)                                               //perm:read
+	GasEstimateGasPremium(ctx context.Context, nblocksincl uint64, sender address.Address, gaslimit int64, tsk types.TipSetKey) (big.Int, error)                       //perm:read
+
+	// MethodGroup: Network
+
+	ID(ctx context.Context) (peer.ID, error) //perm:read
+
+	// MethodGroup: Common
+
+	// StartTime returns node start time
+	StartTime(context.Context) (time.Time, error) //perm:read
+}
+
+// UnSupport is a subset of the venus v1.FullNode
+// Requests will be rejected
+type UnSupport interface {
+	// MethodGroup: ChainInfo
+
+	VerifyEntry(parent, child *types.BeaconEntry, height abi.ChainEpoch) bool //perm:read
+	ChainSetHead(ctx context.Context, key types.TipSetKey) error              //perm:admin
+
+	// MethodGroup: BlockStore
+
+	ChainDeleteObj(ctx context.Context, obj cid.Cid) error //perm:admin
+
+	// ChainPutObj puts a given object into the block store
+	ChainPutObj(context.Context, blocks.Block) error //perm:admin
+
+	// MethodGroup: MessagePool
+
+	MpoolDeleteByAdress(ctx context.Context, addr address.Address) error                                                           //perm:admin
+	MpoolSetConfig(ctx context.Context, cfg *types.MpoolConfig) error                                                              //perm:admin
+	MpoolClear(ctx context.Context, local bool) error                                                                              //perm:write
+	MpoolPushUntrusted(ctx context.Context, smsg *types.SignedMessage) (cid.Cid, error)                                            //perm:write
+	MpoolBatchPushMessage(ctx context.Context, msgs []*types.Message, spec *types.MessageSendSpec) ([]*types.SignedMessage, error) //perm:sign
+
+	// MethodGroup: Network
+
+	NetFindProvidersAsync(ctx context.Context, key cid.Cid, count int) <-chan peer.AddrInfo //perm:read
+	NetGetClosestPeers(ctx context.Context, key string) ([]peer.ID, error)                  //perm:read
+	NetConnectedness(context.Context, peer.ID) (libnetwork.Connectedness, error)            //perm:read
+	NetFindPeer(ctx context.Context, p peer.ID) (peer.AddrInfo, error)                      //perm:read
+	NetConnect(ctx context.Context, pi peer.AddrInfo) error                                 //perm:admin
+	NetPeers(ctx context.Context) ([]peer.AddrInfo, error)                                  //perm:read
+	NetPeerInfo(ctx context.Context, p peer.ID) (*types.ExtendedPeerInfo, error)            //perm:read
+	NetAgentVersion(ctx context.Context, p peer.ID) (string, error)                         //perm:read
+	NetPing(ctx context.Context, p peer.ID) (time.Duration, error)                          //perm:read
+	NetDisconnect(ctx context.Context, p peer.ID) error                                     //perm:admin
+	NetAutoNatStatus(context.Context) (types.NatInfo, error)                                //perm:read
+	NetPubsubScores(context.Context) ([]types.PubsubScore, error)                           //perm:read
+
+	// NetBandwidthStats returns statistics about the nodes total bandwidth
+	// usage and current rate across all peers and protocols.
+	NetBandwidthStats(ctx context.Context) (metrics.Stats, error) //perm:read
+
+	// NetBandwidthStatsByPeer returns statistics about the nodes bandwidth
+	// usage and current rate per peer
+	NetBandwidthStatsByPeer(ctx context.Context) (map[string]metrics.Stats, error) //perm:read
+
+	// NetBandwidthStatsByProtocol returns statistics about the nodes bandwidth
+	// usage and current rate per protocol
+	NetBandwidthStatsByProtocol(ctx context.Context) (map[protocol.ID]metrics.Stats, error) //perm:read
+
+	NetProtectRemove(ctx context.Context, acl []peer.ID) error //perm:admin
+	NetProtectList(ctx context.Context) ([]peer.ID, error)     //perm:read
+
+	// MethodGroup: Paychan
+
+	// PaychGet gets or creates a payment channel between address pair
+	//  The specified amount will be reserved for use. If there aren't enough non-reserved funds
+	//    available, funds will be added through an on-chain message.
+	//  - When opts.OffChain is true, this call will not cause any messages to be sent to the chain (no automatic
+	//    channel creation/funds adding). If the operation can't be performed without sending a message an error will be
+	//    returned. Note that even when this option is specified, this call can be blocked by previous operations on the
+	//    channel waiting for on-chain operations.
+	PaychGet(ctx context.Context, from, to address.Address, amt types.BigInt, opts types.PaychGetOpts) (*types.ChannelInfo, error) //perm:sign
+
+	// PaychFund gets or creates a payment channel between address pair.
+	// The specified amount will be added to the channel through on-chain send for future use
+	PaychFund(ctx context.Context, from, to address.Address, amt types.BigInt) (*types.ChannelInfo, error) //perm:sign
+
+	// PaychAvailableFunds get the status of an outbound payment channel
+	// @pch: payment channel address
+	PaychAvailableFunds(ctx context.Context, ch address.Address) (*types.ChannelAvailableFunds, error) //perm:sign
+
+	// PaychAvailableFundsByFromTo  get the status of an outbound payment channel
+	// @from: the payment channel sender
+	// @to: he payment channel recipient
+	PaychAvailableFundsByFromTo(ctx context.Context, from, to address.Address) (*types.ChannelAvailableFunds, error) //perm:sign
+
+	// PaychGetWaitReady waits until the create channel / add funds message with the sentinel
+	// @sentinel: given message CID arrives.
+	// @ch: the returned channel address can safely be used against the Manager methods.
+	PaychGetWaitReady(ctx context.Context, sentinel cid.Cid) (address.Address, error) //perm:sign
+
+	// PaychAllocateLane Allocate late creates a lane within a payment channel so that calls to
+	// CreatePaymentVoucher will automatically make vouchers only for the difference in total
+	PaychAllocateLane(ctx context.Context, ch address.Address) (uint64, error) //perm:sign
+
+	// PaychNewPayment aggregate vouchers into a new lane
+	// @from: the payment channel sender
+	// @to: the payment channel recipient
+	// @vouchers: the outstanding (non-redeemed) vouchers
+	PaychNewPayment(ctx context.Context, from, to address.Address, vouchers []types.VoucherSpec) (*types.PaymentInfo, error) //perm:sign
+
+	// PaychSettle update payment channel status to settle
+	// After a settlement period (currently 12 hours) either party to the payment channel can call collect on chain
+	// @pch: payment channel address
+	PaychSettle(ctx context.Context, addr address.Address) (cid.Cid, error) //perm:sign
+
+	// PaychCollect update payment channel status to collect
+	// Collect sends the value of submitted vouchers to the channel recipient (the provider),
+	// and refunds the remaining channel balance to the channel creator (the client).
+	// @pch: payment channel address
+	PaychCollect(ctx context.Context, addr address.Address) (cid.Cid, error) //perm:sign
+
+	// PaychVoucherAdd adds a voucher for an inbound channel.
+	// If the channel is not in the store, fetches the channel from state (and checks that
+	// the channel To address is owned by the wallet).
+	PaychVoucherAdd(ctx context.Context, ch address.Address, sv *types.SignedVoucher, proof []byte, minDelta big.Int) (big.Int, error) //perm:write
+
+	// PaychVoucherCreate creates a new signed voucher on the given payment channel
+	// with the given lane and amount.  The value passed in is exactly the value
+	// that will be used to create the voucher, so if previous vouchers exist, the
+	// actual additional value of this voucher will only be the difference between
+	// the two.
+	// If there are insufficient funds in the channel to create the voucher,
+	// returns a nil voucher and the shortfall.
+	PaychVoucherCreate(ctx context.Context, pch address.Address, amt big.Int, lane uint64) (*types.VoucherCreateResult, error) //perm:sign
+
+	// PaychVoucherList list vouchers in payment channel
+	// @pch: payment channel address
+	PaychVoucherList(ctx context.Context, pch address.Address) ([]*types.SignedVoucher, error) //perm:write
+
+	// PaychVoucherSubmit Submit voucher to chain to update payment channel state
+	// @pch: payment channel address
+	// @sv: voucher in payment channel
+	PaychVoucherSubmit(ctx context.Context, ch address.Address, sv *types.SignedVoucher, secret []byte, proof []byte) (cid.Cid, error) //perm:sign
+
+	// MethodGroup: Syncer
+
+	ChainSyncHandleNewTipSet(ctx context.Context, ci *types.ChainInfo) error //perm:write
+	SetConcurrent(ctx context.Context, concurrent int64) error               //perm:admin
+	SyncerTracker(ctx context.Context) *types.TargetTracker                  //perm:read
+	Concurrent(ctx context.Context) int64                                    //perm:read
+
+	// SyncCheckpoint marks a blocks as checkpointed, meaning that it won't ever fork away from it.
+	SyncCheckpoint(ctx context.Context, tsk types.TipSetKey) error //perm:admin
+
+	// MethodGroup: Wallet
+
+	WalletExport(ctx context.Context, addr address.Address, password string) (*types.KeyInfo, error)            //perm:admin
+	WalletImport(ctx context.Context, key *types.KeyInfo) (address.Address, error)                              //perm:admin
+	WalletDelete(ctx context.Context, addr address.Address) error                                               //perm:admin
+	WalletNewAddress(ctx context.Context, protocol address.Protocol) (address.Address, error)                   //perm:write
+	WalletDefaultAddress(ctx context.Context) (address.Address, error)                                          //perm:write
+	WalletAddresses(ctx context.Context) []address.Address                                                      //perm:admin
+	WalletSetDefault(ctx context.Context, addr address.Address) error                                           //perm:write
+	WalletSignMessage(ctx context.Context, k address.Address, msg *types.Message) (*types.SignedMessage, error) //perm:sign
+	LockWallet(ctx context.Context) error                                                                       //perm:admin
+	UnLockWallet(ctx context.Context, password []byte) error                                                    //perm:admin
+	SetPassword(ctx context.Context, password []byte) error                                                     //perm:admin
+	HasPassword(ctx context.Context) bool                                                                       //perm:admin
+	WalletState(ctx context.Context) int                                                                        //perm:admin
+}
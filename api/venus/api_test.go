@@ -0,0 +1,71 @@
+package venus
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "github.com/filecoin-project/venus/venus-shared/api/chain/v1"
+)
+
+// readOnlyUnSupport lists the read-only methods that are rejected on purpose
+var readOnlyUnSupport = map[string]string{
+	"VerifyEntry":   "takes no context, which the permission check and the wrappers require",
+	"Concurrent":    "describes the syncer of a single upstream",
+	"SyncerTracker": "describes the syncer of a single upstream",
+
+	"NetFindProvidersAsync":       "describes the libp2p host of a single upstream",
+	"NetGetClosestPeers":          "describes the libp2p host of a single upstream",
+	"NetConnectedness":            "describes the libp2p host of a single upstream",
+	"NetFindPeer":                 "describes the libp2p host of a single upstream",
+	"NetPeers":                    "describes the libp2p host of a single upstream",
+	"NetPeerInfo":                 "describes the libp2p host of a single upstream",
+	"NetAgentVersion":             "describes the libp2p host of a single upstream",
+	"NetPing":                     "describes the libp2p host of a single upstream",
+	"NetAutoNatStatus":            "describes the libp2p host of a single upstream",
+	"NetPubsubScores":             "describes the libp2p host of a single upstream",
+	"NetBandwidthStats":           "describes the libp2p host of a single upstream",
+	"NetBandwidthStatsByPeer":     "describes the libp2p host of a single upstream",
+	"NetBandwidthStatsByProtocol": "describes the libp2p host of a single upstream",
+	"NetProtectList":              "describes the libp2p host of a single upstream",
+}
+
+// permOf collects the perm tags of all the methods in venus v1.FullNodeStruct
+func permOf(t reflect.Type, perms map[string]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Name == "Internal" {
+			for j := 0; j < field.Type.NumField(); j++ {
+				meth := field.Type.Field(j)
+				perms[meth.Name] = meth.Tag.Get("perm")
+			}
+			continue
+		}
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			permOf(field.Type, perms)
+		}
+	}
+}
+
+func TestReadOnlyMethodsSupported(t *testing.T) {
+	perms := map[string]string{}
+	permOf(reflect.TypeOf(v1.FullNodeStruct{}), perms)
+
+	unsupport := reflect.TypeOf((*UnSupport)(nil)).Elem()
+	for i := 0; i < unsupport.NumMethod(); i++ {
+		name := unsupport.Method(i).Name
+		if perms[name] != "read" {
+			continue
+		}
+
+		if _, ok := readOnlyUnSupport[name]; !ok {
+			t.Errorf("read-only method %s is unsupported, move it to Proxy or Local, or explain why in readOnlyUnSupport", name)
+		}
+	}
+
+	for name := range readOnlyUnSupport {
+		if _, ok := unsupport.MethodByName(name); !ok {
+			t.Errorf("%s is not in UnSupport any more, remove it from readOnlyUnSupport", name)
+		}
+	}
+}
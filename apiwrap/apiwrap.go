@@ -0,0 +1,80 @@
+// Package apiwrap builds api proxies whose calls go through a handler,
+// it's shared by the layers wrapped around the served api and the upstream clients.
+package apiwrap
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/filecoin-project/lotus/api"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// Handler is called instead of the method, fn calls the wrapped method with args.
+// The first one of args is always the context of the call.
+type Handler func(method string, fn reflect.Value, args []reflect.Value) []reflect.Value
+
+// WrapInternal sets the func fields of out, which should be a pointer to an Internal struct of the api proxy,
+// to call the methods of in with the same names through the handler.
+// The few methods without a context, e.g. VerifyEntry of venus, are called directly.
+func WrapInternal(in interface{}, out interface{}, h Handler) {
+	vin := reflect.ValueOf(in)
+	vout := reflect.ValueOf(out).Elem()
+
+	for i := 0; i < vout.NumField(); i++ {
+		field := vout.Type().Field(i)
+		if field.Type.Kind() != reflect.Func {
+			continue
+		}
+
+		fn := vin.MethodByName(field.Name)
+		if !fn.IsValid() {
+			continue
+		}
+
+		if fn.Type().NumIn() == 0 || fn.Type().In(0) != contextType {
+			vout.Field(i).Set(fn)
+			continue
+		}
+
+		method := field.Name
+		vout.Field(i).Set(reflect.MakeFunc(field.Type, func(args []reflect.Value) []reflect.Value {
+			return h(method, fn, args)
+		}))
+	}
+}
+
+// FullNode builds a api.FullNodeStruct, whose methods call the ones of in through the handler
+func FullNode(in api.FullNode, h Handler) *api.FullNodeStruct {
+	out := new(api.FullNodeStruct)
+	for _, internal := range api.GetInternalStructs(out) {
+		WrapInternal(in, internal, h)
+	}
+
+	return out
+}
+
+// ErrorResults builds the zero results of the func type, with the last one set to err if it's an error
+func ErrorResults(typ reflect.Type, err error) []reflect.Value {
+	out := make([]reflect.Value, typ.NumOut())
+	for i := range out {
+		out[i] = reflect.Zero(typ.Out(i))
+	}
+
+	if last := typ.NumOut() - 1; last >= 0 && typ.Out(last) == errorType {
+		out[last] = reflect.ValueOf(&err).Elem()
+	}
+
+	return out
+}
+
+// ResultError returns the error of the results, which is the last one if it's an error
+func ResultError(out []reflect.Value) error {
+	if len(out) == 0 || out[len(out)-1].Type() != errorType || out[len(out)-1].IsNil() {
+		return nil
+	}
+
+	return out[len(out)-1].Interface().(error)
+}
@@ -0,0 +1,150 @@
+package apiwrap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	vapi "github.com/filecoin-project/venus/venus-shared/api"
+	v1 "github.com/filecoin-project/venus/venus-shared/api/chain/v1"
+	logging "github.com/ipfs/go-log/v2"
+)
+
+var log = logging.Logger("apiwrap")
+
+// VenusFullNode builds a v1.FullNodeStruct of venus, whose methods call the ones of in through the handler
+func VenusFullNode(in v1.FullNode, h Handler) *v1.FullNodeStruct {
+	out := new(v1.FullNodeStruct)
+	for _, internal := range vapi.GetInternalStructs(out) {
+		WrapInternal(in, internal, h)
+	}
+
+	return out
+}
+
+// ConvertToVenus builds a v1.FullNodeStruct of venus, whose methods call the ones of in with the same names.
+// The params and the results are converted through their json encoding, which the lotus types share with the venus ones.
+// The methods missing in in are left unset.
+func ConvertToVenus(in interface{}) *v1.FullNodeStruct {
+	vin := reflect.ValueOf(in)
+	out := new(v1.FullNodeStruct)
+	for _, internal := range vapi.GetInternalStructs(out) {
+		vout := reflect.ValueOf(internal).Elem()
+		for i := 0; i < vout.NumField(); i++ {
+			field := vout.Type().Field(i)
+			if field.Type.Kind() != reflect.Func {
+				continue
+			}
+
+			fn := vin.MethodByName(field.Name)
+			if !fn.IsValid() {
+				continue
+			}
+
+			vout.Field(i).Set(convertFunc(field.Name, fn, field.Type))
+		}
+	}
+
+	return out
+}
+
+// convertFunc builds a func of type typ, which calls fn with the converted params and converts the results back
+func convertFunc(method string, fn reflect.Value, typ reflect.Type) reflect.Value {
+	return reflect.MakeFunc(typ, func(args []reflect.Value) []reflect.Value {
+		fnTyp := fn.Type()
+		if fnTyp.NumIn() != len(args) || fnTyp.NumOut() != typ.NumOut() {
+			return ErrorResults(typ, fmt.Errorf("convert %s: signatures mismatch, %s and %s", method, fnTyp, typ))
+		}
+
+		ctx := context.Background()
+		if len(args) > 0 && args[0].Type() == contextType {
+			ctx = args[0].Interface().(context.Context)
+		}
+
+		in := make([]reflect.Value, len(args))
+		for i := range args {
+			arg, err := convert(ctx, args[i], fnTyp.In(i))
+			if err != nil {
+				return ErrorResults(typ, fmt.Errorf("convert %s param #%d: %w", method, i, err))
+			}
+			in[i] = arg
+		}
+
+		out := fn.Call(in)
+		if err := ResultError(out); err != nil {
+			return ErrorResults(typ, err)
+		}
+
+		res := make([]reflect.Value, len(out))
+		for i := range out {
+			r, err := convert(ctx, out[i], typ.Out(i))
+			if err != nil {
+				return ErrorResults(typ, fmt.Errorf("convert %s result #%d: %w", method, i, err))
+			}
+			res[i] = r
+		}
+		return res
+	})
+}
+
+// convert converts v to typ through the json encoding, unless v is assignable to typ.
+// The elements of a channel are converted one by one until it's closed or ctx is done.
+func convert(ctx context.Context, v reflect.Value, typ reflect.Type) (reflect.Value, error) {
+	if v.Type().AssignableTo(typ) {
+		out := reflect.New(typ).Elem()
+		out.Set(v)
+		return out, nil
+	}
+
+	if v.Kind() == reflect.Chan && typ.Kind() == reflect.Chan {
+		if v.IsNil() {
+			return reflect.Zero(typ), nil
+		}
+
+		ch := reflect.MakeChan(reflect.ChanOf(reflect.BothDir, typ.Elem()), 0)
+		go forwardChan(ctx, v, ch)
+		return ch.Convert(typ), nil
+	}
+
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	out := reflect.New(typ)
+	if err := json.Unmarshal(data, out.Interface()); err != nil {
+		return reflect.Value{}, err
+	}
+	return out.Elem(), nil
+}
+
+// forwardChan converts the elements received from src and sends them to dst, which is closed after src is closed or ctx is done
+func forwardChan(ctx context.Context, src, dst reflect.Value) {
+	defer dst.Close()
+
+	done := reflect.ValueOf(ctx.Done())
+	for {
+		chosen, elem, ok := reflect.Select([]reflect.SelectCase{
+			{Dir: reflect.SelectRecv, Chan: src},
+			{Dir: reflect.SelectRecv, Chan: done},
+		})
+		if chosen == 1 || !ok {
+			return
+		}
+
+		converted, err := convert(ctx, elem, dst.Type().Elem())
+		if err != nil {
+			log.Errorf("convert element of %s: %s", src.Type(), err)
+			return
+		}
+
+		chosen, _, _ = reflect.Select([]reflect.SelectCase{
+			{Dir: reflect.SelectSend, Chan: dst, Send: converted},
+			{Dir: reflect.SelectRecv, Chan: done},
+		})
+		if chosen == 1 {
+			return
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package apiwrap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/node/modules/dtypes"
+	vtypes "github.com/filecoin-project/venus/venus-shared/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// lotusLocal serves a few methods with the lotus types
+type lotusLocal struct {
+	changes chan []*api.HeadChange
+}
+
+func (l *lotusLocal) StateNetworkName(context.Context) (dtypes.NetworkName, error) {
+	return "calibrationnet", nil
+}
+
+func (l *lotusLocal) ChainNotify(context.Context) (<-chan []*api.HeadChange, error) {
+	return l.changes, nil
+}
+
+func TestConvertToVenus(t *testing.T) {
+	local := &lotusLocal{changes: make(chan []*api.HeadChange, 1)}
+	full := ConvertToVenus(local)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	name, err := full.StateNetworkName(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, vtypes.NetworkName("calibrationnet"), name)
+
+	// the methods missing in the lotus impl are left unset
+	assert.Nil(t, full.IChainStruct.IChainInfoStruct.Internal.ChainHead)
+
+	ch, err := full.ChainNotify(ctx)
+	assert.NoError(t, err)
+	local.changes <- []*api.HeadChange{{Type: "current"}}
+	changes := <-ch
+	assert.Len(t, changes, 1)
+	assert.Equal(t, vtypes.HCCurrent, changes[0].Type)
+
+	// the converted channel is closed after the ctx is done
+	cancel()
+	_, ok := <-ch
+	assert.False(t, ok)
+}
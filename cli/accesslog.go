@@ -0,0 +1,269 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ipfs-force-community/sophon-co/accesslog"
+	"github.com/ipfs-force-community/sophon-co/config"
+)
+
+const followInterval = 500 * time.Millisecond
+
+var AccessLogCmd = &cli.Command{
+	Name:  "access-log",
+	Usage: "tail and filter the access log",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "file",
+			Usage: "path of the access log, the one in the config of the repo is used by default",
+		},
+		&cli.StringFlag{
+			Name:  "method",
+			Usage: "show the calls of the methods matching the pattern, e.g. State*",
+		},
+		&cli.StringFlag{
+			Name:  "user",
+			Usage: "show the calls of the user",
+		},
+		&cli.StringFlag{
+			Name:  "node",
+			Usage: "show the calls served by the nodes containing the address",
+		},
+		&cli.BoolFlag{
+			Name:  "errors",
+			Usage: "show the failed calls only",
+		},
+		&cli.DurationFlag{
+			Name:  "since",
+			Usage: "show the calls made in the duration, e.g. 1h",
+		},
+		&cli.IntFlag{
+			Name:    "lines",
+			Aliases: []string{"n"},
+			Usage:   "number of the last calls to show, 0 shows all",
+			Value:   20,
+		},
+		&cli.BoolFlag{
+			Name:    "follow",
+			Aliases: []string{"f"},
+			Usage:   "keep showing the new calls",
+		},
+		&cli.BoolFlag{
+			Name:  "json",
+			Usage: "print the entries as json lines",
+		},
+	},
+	Action: func(cctx *cli.Context) error {
+		path, err := accessLogPath(cctx)
+		if err != nil {
+			return err
+		}
+
+		filter := accesslog.Filter{
+			Method:     cctx.String("method"),
+			User:       cctx.String("user"),
+			Node:       cctx.String("node"),
+			ErrorsOnly: cctx.Bool("errors"),
+		}
+		if cctx.IsSet("since") {
+			filter.Since = time.Now().Add(-cctx.Duration("since"))
+		}
+
+		show := func(e *accesslog.Entry) error {
+			return printAccessLogEntry(cctx.App.Writer, e, cctx.Bool("json"))
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close() // nolint:errcheck
+
+		reader := newEntryReader(file)
+		lines := cctx.Int("lines")
+		var last []*accesslog.Entry
+		for {
+			e, err := reader.next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			if e == nil || !filter.Match(e) {
+				continue
+			}
+
+			last = append(last, e)
+			if lines > 0 && len(last) > lines {
+				last = last[1:]
+			}
+		}
+
+		for _, e := range last {
+			if err := show(e); err != nil {
+				return err
+			}
+		}
+
+		if !cctx.Bool("follow") {
+			return nil
+		}
+
+		return followAccessLog(cctx, path, file, reader, filter, show)
+	},
+}
+
+// followAccessLog prints the new entries, the file is opened again when it's rotated
+func followAccessLog(cctx *cli.Context, path string, file *os.File, reader *entryReader, filter accesslog.Filter, show func(*accesslog.Entry) error) error {
+	ticker := time.NewTicker(followInterval)
+	defer ticker.Stop()
+	// the file opened after the rotation
+	defer func() { _ = file.Close() }()
+
+	for {
+		if err := showEntries(reader, filter, show); err != nil {
+			return err
+		}
+
+		select {
+		case <-cctx.Context.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		rotated, err := isRotated(file, path)
+		if err != nil {
+			return err
+		}
+		if rotated {
+			// the lines written just before the rotation are still in the old file
+			if err := showEntries(reader, filter, show); err != nil {
+				return err
+			}
+			_ = file.Close()
+			if file, err = os.Open(path); err != nil {
+				return err
+			}
+			reader = newEntryReader(file)
+		}
+	}
+}
+
+// showEntries shows the entries matching filter until there is no whole line to read
+func showEntries(reader *entryReader, filter accesslog.Filter, show func(*accesslog.Entry) error) error {
+	for {
+		e, err := reader.next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if e != nil && filter.Match(e) {
+			if err := show(e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// isRotated returns true if path is no longer the opened file
+func isRotated(file *os.File, path string) (bool, error) {
+	opened, err := file.Stat()
+	if err != nil {
+		return false, err
+	}
+
+	current, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return !os.SameFile(opened, current), nil
+}
+
+// entryReader reads the entries line by line, the incomplete lines are kept until the writer finishes them
+type entryReader struct {
+	r       *bufio.Reader
+	pending []byte
+}
+
+func newEntryReader(r io.Reader) *entryReader {
+	return &entryReader{r: bufio.NewReader(r)}
+}
+
+// next returns the next entry, or io.EOF if there is no whole line. Invalid lines are skipped as nil entries.
+func (er *entryReader) next() (*accesslog.Entry, error) {
+	line, err := er.r.ReadBytes('\n')
+	er.pending = append(er.pending, line...)
+	if err != nil {
+		return nil, err
+	}
+
+	line, er.pending = er.pending, nil
+	var e accesslog.Entry
+	if err := json.Unmarshal(line, &e); err != nil {
+		return nil, nil
+	}
+	return &e, nil
+}
+
+func printAccessLogEntry(w io.Writer, e *accesslog.Entry, asJSON bool) error {
+	if asJSON {
+		b, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(b))
+		return err
+	}
+
+	user := e.User
+	if user == "" {
+		user = e.Host
+	}
+	status := "ok"
+	if e.ErrorClass != "" {
+		status = e.ErrorClass + ": " + e.Error
+	}
+
+	_, err := fmt.Fprintf(w, "%s  %-16s  %-32s  %-24s  %-40s  %6dms  %s\n",
+		e.Time.Local().Format("2006-01-02 15:04:05.000"), user, e.Method, e.Hint,
+		strings.Join(e.Nodes, ","), e.DurationMs, status)
+	return err
+}
+
+func accessLogPath(cctx *cli.Context) (string, error) {
+	if cctx.IsSet("file") {
+		return cctx.String("file"), nil
+	}
+
+	repoPath, err := homedir.Expand(cctx.String("repo"))
+	if err != nil {
+		return "", err
+	}
+	cfg, err := config.ReadConfig(filepath.Join(repoPath, config.ConfigFile))
+	if err != nil {
+		return "", err
+	}
+	if cfg.AccessLog.Path == "" {
+		return "", fmt.Errorf("access log is not enabled in the config of %s", repoPath)
+	}
+
+	return cfg.AccessLog.FilePath(repoPath), nil
+}
@@ -1,9 +1,39 @@
 package api
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type LocalAPI interface {
 	SetWeight(ctx context.Context, addr string, weight int) error //perm:admin
 	ListWeight(ctx context.Context) (map[string]int, error)       //perm:read
 	ListPriority(ctx context.Context) (map[string]int, error)     //perm:read
+
+	// SetUserPool assigns the user to the node pool, the user goes back to the default pool if pool is empty.
+	// The assignment is not persisted, add it to Pool.Users of the config to keep it after restart.
+	SetUserPool(ctx context.Context, user string, pool string) error //perm:admin
+	// ListUserPools returns the users not in the default pool
+	ListUserPools(ctx context.Context) (map[string]string, error) //perm:read
+	// ListNodeLabels returns the labels of the nodes
+	ListNodeLabels(ctx context.Context) (map[string][]string, error) //perm:read
+
+	// CoordinatorState returns the head chosen by the coordinator and the state of all the nodes
+	CoordinatorState(ctx context.Context) (*CoordinatorState, error) //perm:read
+
+	// ListNodes returns the state of all the nodes
+	ListNodes(ctx context.Context) ([]NodeState, error) //perm:read
+	// NodeDetail returns the state of the node with its version and sync state
+	NodeDetail(ctx context.Context, addr string) (*NodeDetail, error) //perm:read
+	// BlockNode sets the weight of the node to 0, so that it's never selected until it's unblocked
+	BlockNode(ctx context.Context, addr string, reason string) error //perm:admin
+	// UnblockNode restores the weight of the node before it's blocked
+	UnblockNode(ctx context.Context, addr string) error //perm:admin
+	// ReconnectNode drops the rpc client of the node and connects to it again
+	ReconnectNode(ctx context.Context, addr string) error //perm:admin
+	// DrainNode stops selecting the node for the new calls, and waits for the calls in flight, including the subscriptions,
+	// to finish in timeout
+	DrainNode(ctx context.Context, addr string, timeout time.Duration) (*DrainResult, error) //perm:admin
+	// UndrainNode admits the draining node to be selected again
+	UndrainNode(ctx context.Context, addr string) error //perm:admin
 }
@@ -1,12 +1,13 @@
 package api
 
 import (
-	"github.com/filecoin-project/go-jsonrpc/auth"
-	"github.com/ipfs-force-community/sophon-auth/core"
+	"github.com/filecoin-project/venus/venus-shared/api/permission"
 )
 
+// PermissionedAPI checks the perm tags of the methods against the permissions of the caller set by sophon-auth,
+// as the full node apis do
 func PermissionedAPI(api LocalAPI) LocalAPI {
 	var out LocalAPIStruct
-	auth.PermissionedProxy(core.PermArr, []auth.Permission{}, api, &out.Internal)
+	permission.PermissionProxy(api, &out)
 	return &out
 }
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs-force-community/sophon-auth/core"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockLocalAPI struct {
+	LocalAPI
+	blocked []string
+}
+
+func (m *mockLocalAPI) ListWeight(context.Context) (map[string]int, error) {
+	return map[string]int{"a": 1}, nil
+}
+
+func (m *mockLocalAPI) BlockNode(_ context.Context, addr string, _ string) error {
+	m.blocked = append(m.blocked, addr)
+	return nil
+}
+
+func (m *mockLocalAPI) DrainNode(context.Context, string, time.Duration) (*DrainResult, error) {
+	return &DrainResult{}, nil
+}
+
+func TestPermissionedAPI(t *testing.T) {
+	full := &mockLocalAPI{}
+	api := PermissionedAPI(full)
+
+	read := core.CtxWithPerm(context.Background(), core.PermRead)
+	admin := core.CtxWithPerm(context.Background(), core.PermAdmin)
+
+	weights, err := api.ListWeight(read)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 1}, weights)
+
+	// the admin methods reject a read-only token
+	err = api.BlockNode(read, "a", "maintenance")
+	assert.ErrorContains(t, err, "missing permission to invoke 'BlockNode'")
+	_, err = api.DrainNode(read, "a", time.Second)
+	assert.ErrorContains(t, err, "missing permission to invoke 'DrainNode'")
+	assert.Empty(t, full.blocked)
+
+	assert.NoError(t, api.BlockNode(admin, "a", "maintenance"))
+	assert.Equal(t, []string{"a"}, full.blocked)
+}
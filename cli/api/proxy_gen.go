@@ -5,23 +5,101 @@ package api
 import (
 	"context"
 	"errors"
+	"time"
 )
 
 var ErrNotSupported = errors.New("method not supported")
 
 type LocalAPIStruct struct {
 	Internal struct {
+		BlockNode func(p0 context.Context, p1 string, p2 string) error `perm:"admin"`
+
+		CoordinatorState func(p0 context.Context) (*CoordinatorState, error) `perm:"read"`
+
+		DrainNode func(p0 context.Context, p1 string, p2 time.Duration) (*DrainResult, error) `perm:"admin"`
+
+		ListNodeLabels func(p0 context.Context) (map[string][]string, error) `perm:"read"`
+
+		ListNodes func(p0 context.Context) ([]NodeState, error) `perm:"read"`
+
 		ListPriority func(p0 context.Context) (map[string]int, error) `perm:"read"`
 
+		ListUserPools func(p0 context.Context) (map[string]string, error) `perm:"read"`
+
 		ListWeight func(p0 context.Context) (map[string]int, error) `perm:"read"`
 
+		NodeDetail func(p0 context.Context, p1 string) (*NodeDetail, error) `perm:"read"`
+
+		ReconnectNode func(p0 context.Context, p1 string) error `perm:"admin"`
+
+		SetUserPool func(p0 context.Context, p1 string, p2 string) error `perm:"admin"`
+
 		SetWeight func(p0 context.Context, p1 string, p2 int) error `perm:"admin"`
+
+		UnblockNode func(p0 context.Context, p1 string) error `perm:"admin"`
+
+		UndrainNode func(p0 context.Context, p1 string) error `perm:"admin"`
 	}
 }
 
 type LocalAPIStub struct {
 }
 
+func (s *LocalAPIStruct) BlockNode(p0 context.Context, p1 string, p2 string) error {
+	if s.Internal.BlockNode == nil {
+		return ErrNotSupported
+	}
+	return s.Internal.BlockNode(p0, p1, p2)
+}
+
+func (s *LocalAPIStub) BlockNode(p0 context.Context, p1 string, p2 string) error {
+	return ErrNotSupported
+}
+
+func (s *LocalAPIStruct) CoordinatorState(p0 context.Context) (*CoordinatorState, error) {
+	if s.Internal.CoordinatorState == nil {
+		return nil, ErrNotSupported
+	}
+	return s.Internal.CoordinatorState(p0)
+}
+
+func (s *LocalAPIStub) CoordinatorState(p0 context.Context) (*CoordinatorState, error) {
+	return nil, ErrNotSupported
+}
+
+func (s *LocalAPIStruct) DrainNode(p0 context.Context, p1 string, p2 time.Duration) (*DrainResult, error) {
+	if s.Internal.DrainNode == nil {
+		return nil, ErrNotSupported
+	}
+	return s.Internal.DrainNode(p0, p1, p2)
+}
+
+func (s *LocalAPIStub) DrainNode(p0 context.Context, p1 string, p2 time.Duration) (*DrainResult, error) {
+	return nil, ErrNotSupported
+}
+
+func (s *LocalAPIStruct) ListNodeLabels(p0 context.Context) (map[string][]string, error) {
+	if s.Internal.ListNodeLabels == nil {
+		return *new(map[string][]string), ErrNotSupported
+	}
+	return s.Internal.ListNodeLabels(p0)
+}
+
+func (s *LocalAPIStub) ListNodeLabels(p0 context.Context) (map[string][]string, error) {
+	return *new(map[string][]string), ErrNotSupported
+}
+
+func (s *LocalAPIStruct) ListNodes(p0 context.Context) ([]NodeState, error) {
+	if s.Internal.ListNodes == nil {
+		return *new([]NodeState), ErrNotSupported
+	}
+	return s.Internal.ListNodes(p0)
+}
+
+func (s *LocalAPIStub) ListNodes(p0 context.Context) ([]NodeState, error) {
+	return *new([]NodeState), ErrNotSupported
+}
+
 func (s *LocalAPIStruct) ListPriority(p0 context.Context) (map[string]int, error) {
 	if s.Internal.ListPriority == nil {
 		return *new(map[string]int), ErrNotSupported
@@ -33,6 +111,17 @@ func (s *LocalAPIStub) ListPriority(p0 context.Context) (map[string]int, error)
 	return *new(map[string]int), ErrNotSupported
 }
 
+func (s *LocalAPIStruct) ListUserPools(p0 context.Context) (map[string]string, error) {
+	if s.Internal.ListUserPools == nil {
+		return *new(map[string]string), ErrNotSupported
+	}
+	return s.Internal.ListUserPools(p0)
+}
+
+func (s *LocalAPIStub) ListUserPools(p0 context.Context) (map[string]string, error) {
+	return *new(map[string]string), ErrNotSupported
+}
+
 func (s *LocalAPIStruct) ListWeight(p0 context.Context) (map[string]int, error) {
 	if s.Internal.ListWeight == nil {
 		return *new(map[string]int), ErrNotSupported
@@ -44,6 +133,39 @@ func (s *LocalAPIStub) ListWeight(p0 context.Context) (map[string]int, error) {
 	return *new(map[string]int), ErrNotSupported
 }
 
+func (s *LocalAPIStruct) NodeDetail(p0 context.Context, p1 string) (*NodeDetail, error) {
+	if s.Internal.NodeDetail == nil {
+		return nil, ErrNotSupported
+	}
+	return s.Internal.NodeDetail(p0, p1)
+}
+
+func (s *LocalAPIStub) NodeDetail(p0 context.Context, p1 string) (*NodeDetail, error) {
+	return nil, ErrNotSupported
+}
+
+func (s *LocalAPIStruct) ReconnectNode(p0 context.Context, p1 string) error {
+	if s.Internal.ReconnectNode == nil {
+		return ErrNotSupported
+	}
+	return s.Internal.ReconnectNode(p0, p1)
+}
+
+func (s *LocalAPIStub) ReconnectNode(p0 context.Context, p1 string) error {
+	return ErrNotSupported
+}
+
+func (s *LocalAPIStruct) SetUserPool(p0 context.Context, p1 string, p2 string) error {
+	if s.Internal.SetUserPool == nil {
+		return ErrNotSupported
+	}
+	return s.Internal.SetUserPool(p0, p1, p2)
+}
+
+func (s *LocalAPIStub) SetUserPool(p0 context.Context, p1 string, p2 string) error {
+	return ErrNotSupported
+}
+
 func (s *LocalAPIStruct) SetWeight(p0 context.Context, p1 string, p2 int) error {
 	if s.Internal.SetWeight == nil {
 		return ErrNotSupported
@@ -55,4 +177,26 @@ func (s *LocalAPIStub) SetWeight(p0 context.Context, p1 string, p2 int) error {
 	return ErrNotSupported
 }
 
+func (s *LocalAPIStruct) UnblockNode(p0 context.Context, p1 string) error {
+	if s.Internal.UnblockNode == nil {
+		return ErrNotSupported
+	}
+	return s.Internal.UnblockNode(p0, p1)
+}
+
+func (s *LocalAPIStub) UnblockNode(p0 context.Context, p1 string) error {
+	return ErrNotSupported
+}
+
+func (s *LocalAPIStruct) UndrainNode(p0 context.Context, p1 string) error {
+	if s.Internal.UndrainNode == nil {
+		return ErrNotSupported
+	}
+	return s.Internal.UndrainNode(p0, p1)
+}
+
+func (s *LocalAPIStub) UndrainNode(p0 context.Context, p1 string) error {
+	return ErrNotSupported
+}
+
 var _ LocalAPI = new(LocalAPIStruct)
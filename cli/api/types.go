@@ -0,0 +1,75 @@
+package api
+
+import (
+	"time"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	lapi "github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+// CoordinatorState is the head chosen by the coordinator and the state of the nodes
+type CoordinatorState struct {
+	Head   types.TipSetKey
+	Height abi.ChainEpoch
+	Weight types.BigInt
+	// HeadNodes are the nodes which have reported the head
+	HeadNodes []string
+	Nodes     []NodeState
+}
+
+// NodeState is the state of an upstream node
+type NodeState struct {
+	Addr     string
+	Name     string
+	Labels   []string
+	Priority int
+	Weight   int
+	// BlockReason is the reason why the node is blocked, see Blocked
+	BlockReason string
+	Blocked     bool
+	// Draining means the node is not selected for the new calls, see LocalAPI.DrainNode
+	Draining bool
+	// InFlight is the number of the calls in flight and queued on the node, including the open subscriptions
+	InFlight int64
+	// Refused means the node is on another network, it's never selected then
+	Refused bool
+	Network string
+
+	// Head is the latest head reported by the node, empty if the node hasn't reported any
+	Head       types.TipSetKey
+	Height     abi.ChainEpoch
+	HeadWeight types.BigInt
+	// Lag is the epochs the node is behind the head of the coordinator
+	Lag abi.ChainEpoch
+	// SinceHeadChange is the time since the node reported the latest head, zero if the node hasn't reported any
+	SinceHeadChange time.Duration
+
+	Connected   bool
+	LastError   string
+	LastErrorAt time.Time
+	// Version is the version of the node got after it's connected
+	Version string
+	// Impl is lotus or venus, Capabilities are the optional features of the node, e.g. eth_rpc, f3 and event_index
+	Impl         string
+	Capabilities []string
+	// Latency is the moving average of the latency of the calls to the node
+	Latency time.Duration
+}
+
+// NodeDetail is the state of the node with the live status got from the node
+type NodeDetail struct {
+	NodeState
+	APIVersion lapi.APIVersion
+	SyncState  *lapi.SyncState
+	// Errors are the errors of the calls to get the live status
+	Errors []string
+}
+
+// DrainResult is the result of draining a node
+type DrainResult struct {
+	Addr string
+	// Drained is true if no call or subscription is in flight on the node, it's safe to stop the node then
+	Drained  bool
+	InFlight int64
+}
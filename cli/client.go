@@ -29,8 +29,13 @@ func NewLocalRPCClient(cctx *cli.Context, opts ...jsonrpc.Option) (local_api.Loc
 	if cctx.IsSet("listen") {
 		addr = cctx.String("listen")
 	}
+	network, err := cfg.Network(cctx.String("network"))
+	if err != nil {
+		return nil, nil, err
+	}
+
 	port := strings.Split(addr, ":")[1]
-	endpoint := fmt.Sprintf("http://127.0.0.1:%s/rpc/admin/v0", port)
+	endpoint := fmt.Sprintf("http://127.0.0.1:%s%s/rpc/admin/v0", port, network.PathPrefix())
 
 	token, err := os.ReadFile(filepath.Join(repoPath, config.TokenFile))
 	token = bytes.TrimSpace(token)
@@ -0,0 +1,224 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+var NodeCmd = &cli.Command{
+	Name:  "node",
+	Usage: "inspect and manage the upstream nodes",
+	Subcommands: []*cli.Command{
+		nodeListCmd,
+		nodeInfoCmd,
+		nodeBlockCmd,
+		nodeUnblockCmd,
+		nodeReconnectCmd,
+		nodeDrainCmd,
+		nodeUndrainCmd,
+	},
+}
+
+var nodeListCmd = &cli.Command{
+	Name:  "list",
+	Usage: "list the nodes",
+	Flags: []cli.Flag{},
+	Action: func(cctx *cli.Context) error {
+		ctx := cctx.Context
+		client, closer, err := NewLocalRPCClient(cctx)
+		if err != nil {
+			return err
+		}
+		defer closer()
+
+		nodes, err := client.ListNodes(ctx)
+		if err != nil {
+			return err
+		}
+
+		tw := tabwriter.NewWriter(cctx.App.Writer, 2, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "Address\tName\tImpl\tVersion\tCapabilities\tLabels\tWeight\tPriority\tHeight\tLag\tLatency")
+		for _, node := range nodes {
+			weight := fmt.Sprint(node.Weight)
+			if node.Blocked {
+				weight = "blocked"
+			}
+			if node.Draining {
+				weight += " (draining)"
+			}
+			if node.Refused {
+				weight = "refused"
+			}
+
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\t%d\t%d\t%s", node.Addr, orDash(node.Name), orDash(node.Impl), orDash(node.Version),
+				orDash(strings.Join(node.Capabilities, ",")), strings.Join(node.Labels, ","), weight, node.Priority, node.Height, node.Lag, node.Latency.Round(time.Millisecond))
+			fmt.Fprintln(tw)
+		}
+		return tw.Flush()
+	},
+}
+
+var nodeInfoCmd = &cli.Command{
+	Name:      "info",
+	Usage:     "show the detailed status of the node",
+	ArgsUsage: "[addr]",
+	Flags:     []cli.Flag{},
+	Action: func(cctx *cli.Context) error {
+		if cctx.NArg() != 1 {
+			return fmt.Errorf("must specify a node address")
+		}
+
+		ctx := cctx.Context
+		client, closer, err := NewLocalRPCClient(cctx)
+		if err != nil {
+			return err
+		}
+		defer closer()
+
+		detail, err := client.NodeDetail(ctx, cctx.Args().First())
+		if err != nil {
+			return err
+		}
+
+		enc := json.NewEncoder(cctx.App.Writer)
+		enc.SetIndent("", "  ")
+		return enc.Encode(detail)
+	},
+}
+
+var nodeBlockCmd = &cli.Command{
+	Name:      "block",
+	Usage:     "block the node, it's never selected until it's unblocked",
+	ArgsUsage: "[addr]",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "reason",
+			Usage: "why the node is blocked",
+		},
+	},
+	Action: func(cctx *cli.Context) error {
+		if cctx.NArg() != 1 {
+			return fmt.Errorf("must specify a node address")
+		}
+
+		ctx := cctx.Context
+		client, closer, err := NewLocalRPCClient(cctx)
+		if err != nil {
+			return err
+		}
+		defer closer()
+
+		return client.BlockNode(ctx, cctx.Args().First(), cctx.String("reason"))
+	},
+}
+
+var nodeUnblockCmd = &cli.Command{
+	Name:      "unblock",
+	Usage:     "unblock the node, the weight before it's blocked is restored",
+	ArgsUsage: "[addr]",
+	Flags:     []cli.Flag{},
+	Action: func(cctx *cli.Context) error {
+		if cctx.NArg() != 1 {
+			return fmt.Errorf("must specify a node address")
+		}
+
+		ctx := cctx.Context
+		client, closer, err := NewLocalRPCClient(cctx)
+		if err != nil {
+			return err
+		}
+		defer closer()
+
+		return client.UnblockNode(ctx, cctx.Args().First())
+	},
+}
+
+var nodeReconnectCmd = &cli.Command{
+	Name:      "reconnect",
+	Usage:     "drop the rpc client of the node and connect to it again",
+	ArgsUsage: "[addr]",
+	Flags:     []cli.Flag{},
+	Action: func(cctx *cli.Context) error {
+		if cctx.NArg() != 1 {
+			return fmt.Errorf("must specify a node address")
+		}
+
+		ctx := cctx.Context
+		client, closer, err := NewLocalRPCClient(cctx)
+		if err != nil {
+			return err
+		}
+		defer closer()
+
+		return client.ReconnectNode(ctx, cctx.Args().First())
+	},
+}
+
+var nodeDrainCmd = &cli.Command{
+	Name:      "drain",
+	Usage:     "stop sending new calls to the node, and wait for the calls and the subscriptions in flight to finish",
+	ArgsUsage: "[addr]",
+	Flags: []cli.Flag{
+		&cli.DurationFlag{
+			Name:  "timeout",
+			Usage: "max time to wait for the calls in flight",
+			Value: 5 * time.Minute,
+		},
+	},
+	Action: func(cctx *cli.Context) error {
+		if cctx.NArg() != 1 {
+			return fmt.Errorf("must specify a node address")
+		}
+
+		ctx := cctx.Context
+		client, closer, err := NewLocalRPCClient(cctx)
+		if err != nil {
+			return err
+		}
+		defer closer()
+
+		res, err := client.DrainNode(ctx, cctx.Args().First(), cctx.Duration("timeout"))
+		if err != nil {
+			return err
+		}
+
+		if !res.Drained {
+			return fmt.Errorf("node %s is draining, but %d calls are still in flight after %s", res.Addr, res.InFlight, cctx.Duration("timeout"))
+		}
+		fmt.Fprintf(cctx.App.Writer, "node %s is drained, it's safe to stop it now\n", res.Addr)
+		return nil
+	},
+}
+
+var nodeUndrainCmd = &cli.Command{
+	Name:      "undrain",
+	Usage:     "send new calls to the drained node again",
+	ArgsUsage: "[addr]",
+	Flags:     []cli.Flag{},
+	Action: func(cctx *cli.Context) error {
+		if cctx.NArg() != 1 {
+			return fmt.Errorf("must specify a node address")
+		}
+
+		ctx := cctx.Context
+		client, closer, err := NewLocalRPCClient(cctx)
+		if err != nil {
+			return err
+		}
+		defer closer()
+
+		return client.UndrainNode(ctx, cctx.Args().First())
+	},
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
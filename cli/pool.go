@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/urfave/cli/v2"
+)
+
+var PoolCmd = &cli.Command{
+	Name:  "pool",
+	Usage: "manipulate the node pools of users",
+	Subcommands: []*cli.Command{
+		poolListCmd,
+		poolSetCmd,
+	},
+}
+
+var poolListCmd = &cli.Command{
+	Name:  "list",
+	Usage: "list the labels of nodes and the pools of users",
+	Flags: []cli.Flag{},
+	Action: func(cctx *cli.Context) error {
+		ctx := cctx.Context
+		client, closer, err := NewLocalRPCClient(cctx)
+		if err != nil {
+			return err
+		}
+		defer closer()
+
+		labels, err := client.ListNodeLabels(ctx)
+		if err != nil {
+			return err
+		}
+
+		pools, err := client.ListUserPools(ctx)
+		if err != nil {
+			return err
+		}
+
+		tw := tabwriter.NewWriter(cctx.App.Writer, 2, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "Address\tLabels")
+		for _, addr := range sortedKeys(labels) {
+			fmt.Fprintf(tw, "%s\t%s", addr, strings.Join(labels[addr], ","))
+			fmt.Fprintln(tw)
+		}
+		fmt.Fprintln(tw)
+
+		fmt.Fprintln(tw, "User\tPool")
+		for _, user := range sortedKeys(pools) {
+			fmt.Fprintf(tw, "%s\t%s", user, pools[user])
+			fmt.Fprintln(tw)
+		}
+		return tw.Flush()
+	},
+}
+
+var poolSetCmd = &cli.Command{
+	Name:  "set",
+	Usage: "assign the user to the pool, the user goes back to the default pool if pool is empty",
+	Description: "The assignment is kept in memory only, and lost on restart.\n" +
+		"Add it to [Pool.Users] of the config to keep it.",
+	ArgsUsage: "[user] [pool]",
+	Flags:     []cli.Flag{},
+	Action: func(cctx *cli.Context) error {
+		// check args
+		if cctx.NArg() < 1 || cctx.NArg() > 2 {
+			return fmt.Errorf("must specify a user and optionally a pool")
+		}
+		user := cctx.Args().Get(0)
+		pool := cctx.Args().Get(1)
+
+		ctx := cctx.Context
+		client, closer, err := NewLocalRPCClient(cctx)
+		if err != nil {
+			return err
+		}
+		defer closer()
+
+		return client.SetUserPool(ctx, user, pool)
+	},
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
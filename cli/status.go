@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+var StatusCmd = &cli.Command{
+	Name:  "status",
+	Usage: "show the head of the coordinator and the state of nodes",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "json",
+			Usage: "print the state as json",
+		},
+	},
+	Action: func(cctx *cli.Context) error {
+		ctx := cctx.Context
+		client, closer, err := NewLocalRPCClient(cctx)
+		if err != nil {
+			return err
+		}
+		defer closer()
+
+		state, err := client.CoordinatorState(ctx)
+		if err != nil {
+			return err
+		}
+
+		if cctx.Bool("json") {
+			enc := json.NewEncoder(cctx.App.Writer)
+			enc.SetIndent("", "  ")
+			return enc.Encode(state)
+		}
+
+		fmt.Fprintf(cctx.App.Writer, "Head: %d %s\n", state.Height, state.Head)
+		fmt.Fprintf(cctx.App.Writer, "Weight: %s\n", state.Weight)
+		fmt.Fprintf(cctx.App.Writer, "Reported by: %s\n\n", strings.Join(state.HeadNodes, ","))
+
+		tw := tabwriter.NewWriter(cctx.App.Writer, 2, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "Address\tLabels\tPriority\tWeight\tHeight\tLag\tConnected\tLast Head\tLast Error")
+		for _, node := range state.Nodes {
+			lastHead := "-"
+			if node.SinceHeadChange > 0 {
+				lastHead = node.SinceHeadChange.Round(time.Second).String() + " ago"
+			}
+
+			lastErr := "-"
+			if node.LastError != "" {
+				lastErr = fmt.Sprintf("%s (%s ago)", node.LastError, time.Since(node.LastErrorAt).Round(time.Second))
+			}
+
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%d\t%t\t%s\t%s", node.Addr, strings.Join(node.Labels, ","),
+				node.Priority, node.Weight, node.Height, node.Lag, node.Connected, lastHead, lastErr)
+			fmt.Fprintln(tw)
+		}
+		return tw.Flush()
+	},
+}
@@ -25,6 +25,10 @@ func main() {
 	local := []*cli.Command{
 		runCmd,
 		lcli.WeightCmd,
+		lcli.PoolCmd,
+		lcli.AccessLogCmd,
+		lcli.StatusCmd,
+		lcli.NodeCmd,
 	}
 
 	jaeger := tracing.SetupJaegerTracing(cliName)
@@ -70,6 +74,10 @@ func main() {
 				Value:   "~/.sophon-co",
 				EnvVars: []string{"SOPHON_CO_PATH"},
 			},
+			&cli.StringFlag{
+				Name:  "network",
+				Usage: "name of the network in the config to manage, the top level one is managed by default",
+			},
 		},
 
 		Commands: local,
@@ -15,20 +15,58 @@ import (
 	"github.com/filecoin-project/go-jsonrpc"
 	"github.com/filecoin-project/lotus/api"
 	"github.com/filecoin-project/lotus/api/v0api"
+	vapi "github.com/filecoin-project/venus/venus-shared/api"
+	v1 "github.com/filecoin-project/venus/venus-shared/api/chain/v1"
 	"github.com/filecoin-project/venus/venus-shared/api/permission"
 	"github.com/ipfs-force-community/metrics"
-	"github.com/ipfs-force-community/metrics/ratelimit"
-	"github.com/ipfs-force-community/sophon-auth/core"
 	"github.com/ipfs-force-community/sophon-auth/jwtclient"
+	"github.com/ipfs-force-community/sophon-co/accesslog"
+	"github.com/ipfs-force-community/sophon-co/acl"
 	local_api "github.com/ipfs-force-community/sophon-co/cli/api"
+	"github.com/ipfs-force-community/sophon-co/co"
 	"github.com/ipfs-force-community/sophon-co/config"
-	logging "github.com/ipfs/go-log/v2"
+	"github.com/ipfs-force-community/sophon-co/cost"
+	local_limiter "github.com/ipfs-force-community/sophon-co/limiter"
 	"go.opencensus.io/plugin/ochttp"
 )
 
-var rateLimitLog = logging.Logger("rate-limit")
+// networkAPI is the apis of the nodes of a network, served under the path prefix of the network
+type networkAPI struct {
+	cfg      config.NetworkConfig
+	full     api.FullNode
+	venus    v1.FullNode
+	localApi local_api.LocalAPI
+	health   *co.Health
+}
+
+// wrapper wraps the methods of in into the func fields of out, which is an Internal struct of the api proxy
+type wrapper func(in interface{}, out interface{})
+
+// wrapFullNode wraps the methods of full with the wrappers in order
+func wrapFullNode(full api.FullNode, wrappers []wrapper) api.FullNode {
+	for _, wrap := range wrappers {
+		out := new(api.FullNodeStruct)
+		for _, internal := range api.GetInternalStructs(out) {
+			wrap(full, internal)
+		}
+		full = out
+	}
+	return full
+}
+
+// wrapVenusFullNode wraps the methods of the venus api with the wrappers in order
+func wrapVenusFullNode(full v1.FullNode, wrappers []wrapper) v1.FullNode {
+	for _, wrap := range wrappers {
+		out := new(v1.FullNodeStruct)
+		for _, internal := range vapi.GetInternalStructs(out) {
+			wrap(full, internal)
+		}
+		full = out
+	}
+	return full
+}
 
-func serveRPC(ctx context.Context, cfg *config.Config, jwt jwtclient.IJwtAuthClient, full api.FullNode, localApi local_api.LocalAPI, stop dix.StopFunc, maxRequestSize int64) error {
+func serveRPC(ctx context.Context, cfg *config.Config, jwt jwtclient.IJwtAuthClient, networks []*networkAPI, stop dix.StopFunc, maxRequestSize int64) error {
 	serverOptions := []jsonrpc.ServerOption{}
 	if maxRequestSize > 0 {
 		serverOptions = append(serverOptions, jsonrpc.WithMaxRequestSize(maxRequestSize))
@@ -42,26 +80,48 @@ func serveRPC(ctx context.Context, cfg *config.Config, jwt jwtclient.IJwtAuthCli
 		remoteJwtCli, _ = jwtclient.NewAuthClient(cfg.Auth.URL, string(cfg.Auth.Token))
 	}
 
-	pma := new(api.FullNodeStruct)
-	permission.PermissionProxy(full, pma)
+	// the wrappers are shared by the networks and the apis, so are the limits and the access log
+	var wrappers []wrapper
 	if len(cfg.RateLimit.Redis) > 0 && remoteJwtCli != nil {
 		log.Infof("use rate limit %s", cfg.RateLimit.Redis)
-		limiter, err := ratelimit.NewRateLimitHandler(
-			cfg.RateLimit.Redis,
-			nil, &core.ValueFromCtx{},
-			jwtclient.WarpLimitFinder(remoteJwtCli),
-			rateLimitLog,
-		)
+		limiter := local_limiter.NewRedis(cfg.RateLimit.Redis, jwtclient.WarpLimitFinder(remoteJwtCli), cost.New(cfg.Cost.Methods))
+		wrappers = append(wrappers, limiter.WrapFunctions)
+	} else if opt := localLimitOption(cfg.RateLimit, cfg.Cost); opt.Enabled() {
+		log.Info("use in-process rate limit")
+		limiter, err := local_limiter.New(opt)
+		if err != nil {
+			return err
+		}
+
+		wrappers = append(wrappers, limiter.WrapFunctions)
+	}
+
+	var policy *acl.ACL
+	if len(cfg.ACL.PolicyFile) > 0 {
+		var err error
+		policy, err = acl.New(cfg.ACL.PolicyFile)
+		if err != nil {
+			return err
+		}
+
+		wrappers = append(wrappers, policy.WrapFunctions)
+	}
+
+	if len(cfg.AccessLog.Path) > 0 {
+		accessLog, err := accesslog.New(accesslog.Option{
+			Path:       cfg.AccessLog.Path,
+			MaxSize:    cfg.AccessLog.MaxSize,
+			MaxBackups: cfg.AccessLog.MaxBackups,
+			Level:      cfg.AccessLog.Level,
+			Methods:    cfg.AccessLog.Methods,
+		})
 		if err != nil {
 			return err
 		}
+		defer accessLog.Close() // nolint:errcheck
 
-		var rateLimitAPI api.FullNodeStruct
-		limiter.WrapFunctions(full, &rateLimitAPI.Internal)
-		limiter.WrapFunctions(full, &rateLimitAPI.NetStruct.Internal)
-		limiter.WrapFunctions(full, &rateLimitAPI.VenusAPIStruct.Internal)
-		limiter.WrapFunctions(full, &rateLimitAPI.CommonStruct.Internal)
-		pma = &rateLimitAPI
+		log.Infof("write access log to %s", cfg.AccessLog.Path)
+		wrappers = append(wrappers, accessLog.WrapFunctions)
 	}
 
 	mux := http.NewServeMux()
@@ -79,13 +139,40 @@ func serveRPC(ctx context.Context, cfg *config.Config, jwt jwtclient.IJwtAuthCli
 		} else {
 			handler = (http.Handler)(jwtclient.NewAuthMux(jwt, nil, rpcSer))
 		}
-		mux.Handle(path, handler)
+		mux.Handle(path, acl.TokenHandler(handler))
 	}
 
-	serveRpc("/rpc/v0", &v0api.WrapperV1Full{FullNode: pma}, jsonrpc.NewServer(serverOptions...), false)
-	serveRpc("/rpc/v1", pma, jsonrpc.NewServer(serverOptions...), true)
-	serveRpc("/rpc/admin/v0", localApi, jsonrpc.NewServer(serverOptions...), false)
-	mux.Handle("/healthcheck", healthcheck.Handler())
+	for _, network := range networks {
+		prefix := network.cfg.PathPrefix()
+		if prefix != "" {
+			log.Infof("serve network %s under %s", network.cfg.Name, prefix)
+		}
+
+		pma := new(api.FullNodeStruct)
+		permission.PermissionProxy(network.full, pma)
+		full := wrapFullNode(pma, wrappers)
+
+		// the venus api is served to the venus-shared clients, e.g. sophon-miner, the calls are routed to the venus nodes
+		venusPma := new(v1.FullNodeStruct)
+		permission.PermissionProxy(network.venus, venusPma)
+		venusFull := wrapVenusFullNode(venusPma, wrappers)
+
+		serveRpc(prefix+"/rpc/v0", &v0api.WrapperV1Full{FullNode: full}, jsonrpc.NewServer(serverOptions...), false)
+		serveRpc(prefix+"/rpc/v1", full, jsonrpc.NewServer(serverOptions...), true)
+		serveRpc(prefix+"/rpc/venus/v1", venusFull, jsonrpc.NewServer(serverOptions...), false)
+		serveRpc(prefix+"/rpc/admin/v0", local_api.PermissionedAPI(network.localApi), jsonrpc.NewServer(serverOptions...), false)
+
+		// /healthz is the liveness, which doesn't depend on the upstreams,
+		// /readyz and /healthcheck fail when the nodes fall behind
+		readyCheckers := network.health.ReadyCheckers()
+		healthOpts := make([]healthcheck.Option, 0, len(readyCheckers))
+		for name, checker := range readyCheckers {
+			healthOpts = append(healthOpts, healthcheck.WithChecker(name, checker))
+		}
+		mux.Handle(prefix+"/healthcheck", healthcheck.Handler(healthOpts...))
+		mux.Handle(prefix+"/healthz", network.health.Handler(nil))
+		mux.Handle(prefix+"/readyz", network.health.Handler(readyCheckers))
+	}
 
 	allHandler := (http.Handler)(mux)
 
@@ -128,6 +215,24 @@ func serveRPC(ctx context.Context, cfg *config.Config, jwt jwtclient.IJwtAuthCli
 
 	signal.Notify(sigCh, syscall.SIGTERM, syscall.SIGINT)
 
+	if policy != nil {
+		hupCh := make(chan os.Signal, 1)
+		signal.Notify(hupCh, syscall.SIGHUP)
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+
+				case <-hupCh:
+					if err := policy.Reload(); err != nil {
+						log.Errorf("reload acl policy: %s", err)
+					}
+				}
+			}
+		}()
+	}
+
 	log.Infow("start http server", "addr", cfg.API.ListenAddress)
 	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 		return err
@@ -137,6 +242,28 @@ func serveRPC(ctx context.Context, cfg *config.Config, jwt jwtclient.IJwtAuthCli
 	return nil
 }
 
+func localLimitOption(cfg config.RateLimitConfig, costCfg config.CostConfig) local_limiter.Option {
+	toLimit := func(l config.LimitConfig) local_limiter.Limit {
+		return local_limiter.Limit{Rate: l.Rate, Burst: l.Burst}
+	}
+
+	opt := local_limiter.Option{
+		Global:  toLimit(cfg.Global),
+		Caller:  toLimit(cfg.Caller),
+		Callers: make(map[string]local_limiter.Limit, len(cfg.Callers)),
+		Methods: make(map[string]local_limiter.Limit, len(cfg.Methods)),
+		Costs:   cost.New(costCfg.Methods),
+	}
+	for caller, l := range cfg.Callers {
+		opt.Callers[caller] = toLimit(l)
+	}
+	for method, l := range cfg.Methods {
+		opt.Methods[method] = toLimit(l)
+	}
+
+	return opt
+}
+
 func createEthRPCAliases(as *jsonrpc.RPCServer) {
 	// TODO: maybe use reflect to automatically register all the eth aliases
 	as.AliasMethod("eth_accounts", "Filecoin.EthAccounts")
@@ -11,9 +11,11 @@ import (
 
 	"github.com/urfave/cli/v2"
 
-	"github.com/filecoin-project/lotus/api/v1api"
+	"github.com/dtynn/dix"
 	"github.com/ipfs-force-community/metrics"
-	local_api "github.com/ipfs-force-community/sophon-co/cli/api"
+	"go.opencensus.io/tag"
+
+	"github.com/ipfs-force-community/sophon-co/co"
 	"github.com/ipfs-force-community/sophon-co/config"
 
 	"github.com/ipfs-force-community/sophon-auth/jwtclient"
@@ -49,6 +51,10 @@ var runCmd = &cli.Command{
 			Value:       "v1",
 			DefaultText: "v1",
 		},
+		&cli.BoolFlag{
+			Name:  "gas-aggregate",
+			Usage: "fan out gas estimations to multiple caught-up nodes and combine the results",
+		},
 		&cli.StringFlag{
 			Name: "jaeger-proxy",
 		},
@@ -94,8 +100,11 @@ var runCmd = &cli.Command{
 			}
 		}
 
-		var full v1api.FullNode
-		var localApi local_api.LocalAPI
+		cfg.AccessLog.Path = cfg.AccessLog.FilePath(repoPath)
+
+		if err := cfg.CheckNetworks(); err != nil {
+			return err
+		}
 
 		localJwt, token, err := jwtclient.NewLocalAuthClient()
 		if err != nil {
@@ -105,22 +114,29 @@ var runCmd = &cli.Command{
 		if err != nil {
 			return err
 		}
-		stop, err := service.Build(
-			appCtx,
 
-			dep.MetricsCtxOption(appCtx, cliName),
-
-			dep.APIVersionOption(cctx.String("version")),
-			service.ParseNodeInfoList(config.NodeList(cfg.Nodes), cctx.String("version")),
-			service.FullNode(&full),
-			service.LocalAPI(&localApi),
-		)
-		if err != nil {
-			return err
+		var stops []dix.StopFunc
+		stop := func(ctx context.Context) error {
+			for i := len(stops) - 1; i >= 0; i-- {
+				if err := stops[i](ctx); err != nil {
+					log.Warnf("stop network: %s", err)
+				}
+			}
+			return nil
 		}
-
 		defer stop(context.Background()) // nolint:errcheck
 
+		networkList := cfg.NetworkList()
+		networks := make([]*networkAPI, 0, len(networkList))
+		for _, netCfg := range networkList {
+			network, netStop, err := buildNetwork(appCtx, cctx, cfg, netCfg, repoPath)
+			if err != nil {
+				return fmt.Errorf("build network %q: %w", netCfg.Name, err)
+			}
+			stops = append(stops, netStop)
+			networks = append(networks, network)
+		}
+
 		err = metrics.SetupMetrics(appCtx, cfg.Metrics)
 		if err != nil {
 			return err
@@ -130,8 +146,7 @@ var runCmd = &cli.Command{
 			appCtx,
 			cfg,
 			localJwt,
-			full,
-			localApi,
+			networks,
 			func(ctx context.Context) error {
 				appCancel()
 				stop(ctx) // nolint:errcheck
@@ -142,6 +157,40 @@ var runCmd = &cli.Command{
 	},
 }
 
+// buildNetwork builds the coordinator of the nodes of the network, the metrics are tagged with the name of the network
+func buildNetwork(ctx context.Context, cctx *cli.Context, cfg *config.Config, netCfg config.NetworkConfig, repoPath string) (*networkAPI, dix.StopFunc, error) {
+	mctx := ctx
+	if netCfg.Name != "" {
+		var err error
+		if mctx, err = tag.New(ctx, tag.Upsert(co.NetworkKey, netCfg.Name)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	network := &networkAPI{cfg: netCfg}
+	stop, err := service.Build(
+		ctx,
+
+		dep.MetricsCtxOption(mctx, cliName),
+
+		dep.APIVersionOption(cctx.String("version")),
+		service.ParseNodeInfoList(netCfg.Nodes, cctx.String("version")),
+		service.PoolOption(cfg.Pool),
+		service.NodeOption(*netCfg.Upstream, cfg.Cost),
+		service.HealthOption(*netCfg.Health),
+		service.GasAggregateOption(cfg.GasEstimate),
+		service.HeadStoreOption(netCfg.HeadFilePath(repoPath)),
+		service.FullNode(&network.full),
+		service.VenusFullNode(&network.venus),
+		service.LocalAPI(&network.localApi),
+		service.Health(&network.health),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return network, stop, nil
+}
+
 func hasRepo(repoPath string) (bool, error) {
 	_, err := os.Stat(repoPath)
 	if err != nil {
@@ -168,6 +217,9 @@ func parseFlag(cctx *cli.Context, cfg *config.Config) error {
 	if cctx.IsSet("rate-limit-redis") {
 		cfg.RateLimit.Redis = cctx.String("rate-limit-redis")
 	}
+	if cctx.IsSet("gas-aggregate") {
+		cfg.GasEstimate.Aggregate = cctx.Bool("gas-aggregate")
+	}
 	if cctx.IsSet("jaeger-proxy") {
 		cfg.Trace.JaegerEndpoint = cctx.String("jaeger-proxy")
 		cfg.Trace.JaegerTracingEnabled = true
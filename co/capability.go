@@ -0,0 +1,194 @@
+package co
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+// implementations of the upstream nodes
+const (
+	ImplLotus = "lotus"
+	ImplVenus = "venus"
+)
+
+// Capability is an optional feature of the upstream nodes
+type Capability string
+
+// capabilities required by some of the methods
+const (
+	CapEthRPC     Capability = "eth_rpc"
+	CapF3         Capability = "f3"
+	CapEventIndex Capability = "event_index"
+	// CapVenusAPI is required by the calls of the venus api, which are served by the venus nodes only
+	CapVenusAPI Capability = "venus_api"
+)
+
+// eventMethods read the event index besides the capability of their prefix
+var eventMethods = map[string]struct{}{
+	"GetActorEventsRaw":       {},
+	"SubscribeActorEventsRaw": {},
+	"EthGetLogs":              {},
+	"EthGetFilterLogs":        {},
+	"EthGetFilterChanges":     {},
+	"EthNewFilter":            {},
+}
+
+// requiredCapabilities returns the capabilities the node must have to serve the method
+func requiredCapabilities(method string) []Capability {
+	var caps []Capability
+	switch {
+	case strings.HasPrefix(method, "Eth"):
+		caps = append(caps, CapEthRPC)
+	// F3IsRunning is answered by any node, so that it still answers when no node runs F3
+	case strings.HasPrefix(method, "F3") && method != "F3IsRunning":
+		caps = append(caps, CapF3)
+	}
+	if _, ok := eventMethods[method]; ok {
+		caps = append(caps, CapEventIndex)
+	}
+	return caps
+}
+
+// Capabilities are the implementation and the features of the node discovered after it's connected
+type Capabilities struct {
+	Impl   string
+	EthRPC bool
+	// F3 is true if the node serves the F3 api, whether F3 is running or not yet
+	F3         bool
+	EventIndex bool
+}
+
+// Has returns true if the node has all the capabilities
+func (c Capabilities) Has(caps ...Capability) bool {
+	for _, capability := range caps {
+		var has bool
+		switch capability {
+		case CapEthRPC:
+			has = c.EthRPC
+		case CapF3:
+			has = c.F3
+		case CapEventIndex:
+			has = c.EventIndex
+		case CapVenusAPI:
+			has = c.Impl == ImplVenus
+		}
+		if !has {
+			return false
+		}
+	}
+	return true
+}
+
+// List returns the names of the capabilities the node has
+func (c Capabilities) List() []string {
+	var list []string
+	for _, capability := range []Capability{CapEthRPC, CapF3, CapEventIndex} {
+		if c.Has(capability) {
+			list = append(list, string(capability))
+		}
+	}
+	return list
+}
+
+type methodCtxKey struct{}
+
+// WithMethod returns a ctx carrying the name of the called method, which routes the call to the nodes having
+// the capabilities required by the method
+func WithMethod(ctx context.Context, method string) context.Context {
+	return context.WithValue(ctx, methodCtxKey{}, method)
+}
+
+func methodFromCtx(ctx context.Context) string {
+	method, _ := ctx.Value(methodCtxKey{}).(string)
+	return method
+}
+
+type venusAPICtxKey struct{}
+
+// WithVenusAPI returns a ctx marking the call as one of the venus api, which is routed to the venus nodes only
+func WithVenusAPI(ctx context.Context) context.Context {
+	return context.WithValue(ctx, venusAPICtxKey{}, true)
+}
+
+// callCapabilities returns the capabilities required by the call of the ctx
+func callCapabilities(ctx context.Context) []Capability {
+	caps := requiredCapabilities(methodFromCtx(ctx))
+	if venus, _ := ctx.Value(venusAPICtxKey{}).(bool); venus {
+		caps = append(caps, CapVenusAPI)
+	}
+	return caps
+}
+
+// Capabilities returns the capabilities discovered after the node is connected
+func (n *Node) Capabilities() Capabilities {
+	n.state.lk.Lock()
+	defer n.state.lk.Unlock()
+
+	return n.state.Capabilities
+}
+
+// discoverCapabilities probes the node, a capability is missing if its probe fails for any reason
+func (n *Node) discoverCapabilities() {
+	ctx, cancel := context.WithTimeout(n.ctx, n.opt.APITimeout)
+	defer cancel()
+
+	full := n.rawFullNode()
+	caps := Capabilities{Impl: n.Capabilities().Impl}
+
+	// the implementation is kept if the version is unknown, e.g. the node is busy
+	if ver, err := full.Version(ctx); err == nil {
+		caps.Impl = implOf(ver)
+	} else {
+		n.log.Warnf("call Version: %s", err)
+	}
+
+	if _, err := full.EthChainId(ctx); err == nil {
+		caps.EthRPC = true
+	}
+
+	// F3IsRunning fails only if F3 is disabled on the node
+	if _, err := full.F3IsRunning(ctx); err == nil {
+		caps.F3 = true
+	}
+
+	if head, err := full.ChainHead(ctx); err == nil {
+		height := head.Height()
+		_, err = full.GetActorEventsRaw(ctx, &types.ActorEventFilter{FromHeight: &height, ToHeight: &height})
+		caps.EventIndex = err == nil
+	} else {
+		n.log.Warnf("call ChainHead: %s", err)
+	}
+
+	n.state.lk.Lock()
+	prev := n.state.Capabilities
+	n.state.Capabilities = caps
+	n.state.lk.Unlock()
+
+	if prev != caps {
+		n.log.Infof("capabilities of the %s node: %v", caps.Impl, caps.List())
+	}
+	n.syncVenusClient(caps.Impl)
+}
+
+// implOf detects the implementation from the version of the node,
+// lotus reports its user agent and the block delay, neither of which is in the version of venus
+func implOf(ver api.APIVersion) string {
+	agent := strings.ToLower(ver.Agent)
+	switch {
+	case strings.Contains(agent, ImplVenus):
+		return ImplVenus
+	case agent != "" || ver.BlockDelay > 0:
+		return ImplLotus
+	default:
+		return ImplVenus
+	}
+}
+
+// errNoCapableNode wraps ErrNoNodeAvailable with the missing capabilities
+func errNoCapableNode(caps []Capability) error {
+	return fmt.Errorf("%w: no node has %v", ErrNoNodeAvailable, caps)
+}
@@ -0,0 +1,149 @@
+package co
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/api/v1api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/chain/types/ethtypes"
+	"github.com/stretchr/testify/assert"
+)
+
+type capFullNode struct {
+	v1api.FullNode
+	head       *types.TipSet
+	venus      bool
+	versionErr error
+	eth        bool
+	f3         bool
+	events     bool
+}
+
+func (m *capFullNode) Version(context.Context) (api.APIVersion, error) {
+	if m.versionErr != nil {
+		return api.APIVersion{}, m.versionErr
+	}
+	if m.venus {
+		return api.APIVersion{Version: "1.19.0"}, nil
+	}
+	return api.APIVersion{Version: "1.34.0+mainnet", BlockDelay: 30, Agent: "lotus"}, nil
+}
+
+func (m *capFullNode) EthChainId(context.Context) (ethtypes.EthUint64, error) {
+	if !m.eth {
+		return 0, fmt.Errorf("module disabled, enable with Fevm.EnableEthRPC")
+	}
+	return 314, nil
+}
+
+func (m *capFullNode) F3IsRunning(context.Context) (bool, error) {
+	if !m.f3 {
+		return false, fmt.Errorf("f3 is disabled")
+	}
+	// F3 is enabled, but not running yet
+	return false, nil
+}
+
+func (m *capFullNode) ChainHead(context.Context) (*types.TipSet, error) {
+	return m.head, nil
+}
+
+func (m *capFullNode) GetActorEventsRaw(context.Context, *types.ActorEventFilter) ([]*types.ActorEvent, error) {
+	if !m.events {
+		return nil, fmt.Errorf("actor events are disabled")
+	}
+	return nil, nil
+}
+
+func TestImplOf(t *testing.T) {
+	assert.Equal(t, ImplLotus, implOf(api.APIVersion{Version: "1.34.0+mainnet", BlockDelay: 30, Agent: "lotus"}))
+	assert.Equal(t, ImplLotus, implOf(api.APIVersion{Version: "1.20.0+mainnet", BlockDelay: 30}))
+	assert.Equal(t, ImplVenus, implOf(api.APIVersion{Version: "1.19.0+git.abcdef"}))
+	assert.Equal(t, ImplVenus, implOf(api.APIVersion{Agent: "venus"}))
+}
+
+func TestRequiredCapabilities(t *testing.T) {
+	assert.Empty(t, requiredCapabilities("ChainHead"))
+	assert.Empty(t, requiredCapabilities(""))
+	assert.Equal(t, []Capability{CapEthRPC}, requiredCapabilities("EthCall"))
+	assert.Equal(t, []Capability{CapEthRPC, CapEventIndex}, requiredCapabilities("EthGetLogs"))
+	assert.Equal(t, []Capability{CapF3}, requiredCapabilities("F3GetLatestCertificate"))
+	assert.Equal(t, []Capability{CapF3}, requiredCapabilities("F3GetProgress"))
+	assert.Empty(t, requiredCapabilities("F3IsRunning"))
+	assert.Equal(t, []Capability{CapEventIndex}, requiredCapabilities("GetActorEventsRaw"))
+
+	caps := Capabilities{EthRPC: true, EventIndex: true}
+	assert.True(t, caps.Has())
+	assert.True(t, caps.Has(CapEthRPC, CapEventIndex))
+	assert.False(t, caps.Has(CapEthRPC, CapF3))
+	assert.False(t, caps.Has(CapVenusAPI))
+	assert.True(t, Capabilities{Impl: ImplVenus}.Has(CapVenusAPI))
+	assert.Equal(t, []string{"eth_rpc", "event_index"}, caps.List())
+}
+
+func TestCapabilities(t *testing.T) {
+	head := genTipSet(t, 10)
+	c := setupCoordinator(t, head, map[string]v1api.FullNode{
+		"lotus": &capFullNode{head: head, eth: true, events: true},
+		"venus": &capFullNode{head: head, venus: true, f3: true},
+	})
+	for _, addr := range []string{"lotus", "venus"} {
+		node := c.sel.Node(addr)
+		node.ctx, node.opt, node.log = context.Background(), DefaultNodeOption(), log.With("remote", addr)
+		node.discoverCapabilities()
+	}
+
+	assert.Equal(t, Capabilities{Impl: ImplLotus, EthRPC: true, EventIndex: true}, c.sel.Node("lotus").Capabilities())
+	assert.Equal(t, Capabilities{Impl: ImplVenus, F3: true}, c.sel.Node("venus").Capabilities())
+
+	// the implementation is kept if the version is unknown
+	c.sel.Node("venus").rawFullNode().(*capFullNode).versionErr = fmt.Errorf("context deadline exceeded")
+	c.sel.Node("venus").discoverCapabilities()
+	assert.Equal(t, ImplVenus, c.sel.Node("venus").Capabilities().Impl)
+	c.sel.Node("venus").rawFullNode().(*capFullNode).versionErr = nil
+
+	ctx := context.Background()
+	for method, expected := range map[string]string{
+		"EthCall":                "lotus",
+		"EthGetLogs":             "lotus",
+		"GetActorEventsRaw":      "lotus",
+		"F3GetLatestCertificate": "venus",
+		"F3GetManifest":          "venus",
+	} {
+		for i := 0; i < 4; i++ {
+			node, err := c.sel.Select(WithMethod(ctx, method), types.EmptyTSK)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, node.Addr, method)
+		}
+	}
+
+	// the calls without capabilities are served by any node
+	served := map[string]bool{}
+	for i := 0; i < 64; i++ {
+		node, err := c.sel.Select(WithMethod(ctx, "ChainHead"), types.EmptyTSK)
+		assert.NoError(t, err)
+		served[node.Addr] = true
+	}
+	assert.Len(t, served, 2)
+
+	// the calls of the venus api are served by the venus nodes only
+	for i := 0; i < 4; i++ {
+		node, err := c.sel.Select(WithVenusAPI(WithMethod(ctx, "ChainHead")), types.EmptyTSK)
+		assert.NoError(t, err)
+		assert.Equal(t, "venus", node.Addr)
+	}
+	_, err := c.sel.Select(WithVenusAPI(WithMethod(ctx, "EthCall")), types.EmptyTSK)
+	assert.ErrorIs(t, err, ErrNoNodeAvailable)
+
+	c.sel.Node("venus").state.Capabilities.F3 = false
+	_, err = c.sel.Select(WithMethod(ctx, "F3GetLatestCertificate"), types.EmptyTSK)
+	assert.ErrorIs(t, err, ErrNoNodeAvailable)
+	assert.Contains(t, err.Error(), string(CapF3))
+
+	// F3IsRunning is still served without any F3 node
+	_, err = c.sel.Select(WithMethod(ctx, "F3IsRunning"), types.EmptyTSK)
+	assert.NoError(t, err)
+}
@@ -12,12 +12,16 @@ import (
 
 // ChainNotify impls api.FullNode.ChainNotify
 func (c *Coordinator) ChainNotify(ctx context.Context) (<-chan []*api.HeadChange, error) {
-	subch := c.tspub.Sub(tipsetChangeTopic)
-
 	c.headMu.RLock()
 	head := c.head
 	c.headMu.RUnlock()
 
+	if head == nil {
+		return nil, ErrNoHead
+	}
+
+	subch := c.tspub.Sub(tipsetChangeTopic)
+
 	out := make(chan []*api.HeadChange, 32)
 	out <- []*api.HeadChange{{
 		Type: store.HCCurrent,
@@ -81,5 +85,8 @@ func (c *Coordinator) ChainHead(in0 context.Context) (*types.TipSet, error) {
 	c.headMu.RLock()
 	defer c.headMu.RUnlock()
 
+	if c.head == nil {
+		return nil, ErrNoHead
+	}
 	return c.head, nil
 }
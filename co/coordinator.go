@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	lru "github.com/hashicorp/golang-lru"
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/whyrusleeping/pubsub"
 
@@ -16,6 +17,8 @@ import (
 // common errors
 var (
 	ErrNoNodeAvailable = fmt.Errorf("no node available")
+	// ErrNoHead is returned by the head-dependent calls before the first head arrives
+	ErrNoHead = fmt.Errorf("no head yet")
 )
 
 var log = logging.Logger("sophon-co")
@@ -24,16 +27,35 @@ const (
 	tipsetChangeTopic = "tschange"
 )
 
-// NewCoordinator constructs a Coordinator instance
-func NewCoordinator(ctx *Ctx, head *types.TipSet, weight types.BigInt, sel *Selector) (*Coordinator, error) {
-	return &Coordinator{
-		ctx:    ctx,
-		head:   head,
-		weight: weight,
-		nodes:  make([]string, 0, 16),
-		sel:    sel,
-		tspub:  pubsub.New(256),
-	}, nil
+// NewCoordinator constructs a Coordinator instance, the head persisted in hs is used if it's heavier than the given one
+func NewCoordinator(ctx *Ctx, head *types.TipSet, weight types.BigInt, sel *Selector, hs *HeadStore) (*Coordinator, error) {
+	actorCodes, err := lru.New(actorCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	actorMetas, err := lru.New(actorCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Coordinator{
+		ctx:        ctx,
+		head:       head,
+		weight:     weight,
+		nodes:      make([]string, 0, 16),
+		sel:        sel,
+		hs:         hs,
+		tspub:      pubsub.New(256),
+		actorCodes: actorCodes,
+		actorMetas: actorMetas,
+	}
+	if head != nil {
+		c.recent = []*types.TipSet{head}
+	}
+	c.restoreHead()
+
+	return c, nil
 }
 
 // Coordinator tries to setup the best nodes based on their incoming chain head
@@ -44,10 +66,17 @@ type Coordinator struct {
 	head   *types.TipSet
 	weight types.BigInt
 	nodes  []string
+	// recent are the latest tipsets of the head, used to compute the reorgs and persisted in hs
+	recent    []*types.TipSet
+	headDirty bool
 
 	sel *Selector
+	hs  *HeadStore
 
 	tspub *pubsub.PubSub
+
+	actorCodes *lru.Cache
+	actorMetas *lru.Cache
 }
 
 // Start starts the coordinate loop
@@ -55,10 +84,20 @@ func (c *Coordinator) Start() {
 	log.Info("start head coordinator loop")
 	defer log.Info("stop head coordinator loop")
 
+	driftTicker := time.NewTicker(headDriftInterval)
+	defer driftTicker.Stop()
+
+	persistTicker := time.NewTicker(headPersistInterval)
+	defer persistTicker.Stop()
+
 	for {
 		select {
 		case <-c.ctx.lc.Done():
 			return
+		case <-driftTicker.C:
+			c.recordHead()
+		case <-persistTicker.C:
+			c.persistHead()
 		case hc := <-c.ctx.headCh:
 			c.handleCandidate(hc)
 		case addr := <-c.ctx.errNodeCh:
@@ -67,8 +106,27 @@ func (c *Coordinator) Start() {
 	}
 }
 
+// HeadState returns the head, its weight, and the nodes which have reported it
+func (c *Coordinator) HeadState() (*types.TipSet, types.BigInt, []string) {
+	c.headMu.RLock()
+	defer c.headMu.RUnlock()
+
+	return c.head, c.weight, append([]string{}, c.nodes...)
+}
+
+func (c *Coordinator) recordHead() {
+	c.headMu.RLock()
+	head := c.head
+	c.headMu.RUnlock()
+
+	if head != nil {
+		recordHead(c.ctx.lc, int64(head.Height()), head.MinTimestamp())
+	}
+}
+
 // Stop shuts down the included components
 func (c *Coordinator) Stop() error {
+	c.persistHead()
 	c.tspub.Shutdown()
 	return nil
 }
@@ -92,7 +150,11 @@ func (c *Coordinator) handleCandidate(hc *headCandidate) {
 	//1. more weight
 	//2. if equal weight. select more blocks
 	if c.head == nil || hc.weight.GreaterThan(c.weight) || (hc.weight.Equals(c.weight) && len(hc.ts.Blocks()) > len(c.head.Blocks())) {
-		clog.Info("head replaced")
+		if c.head == nil {
+			clog.Info("got the first head, leave the degraded mode")
+		} else {
+			clog.Info("head replaced")
+		}
 
 		prev := c.head
 		next := hc.ts
@@ -107,11 +169,15 @@ func (c *Coordinator) handleCandidate(hc *headCandidate) {
 		c.head = hc.ts
 		c.weight = hc.weight
 		c.nodes = append(c.nodes[:0], addr)
+		c.pushRecent(headChanges)
 
 		preAddrs := c.sel.getAddrOfPriority(CatchUpPriority)
 		c.sel.setPriority(DelayPriority, preAddrs...)
 		c.sel.setPriority(CatchUpPriority, addr)
 		c.tspub.Pub(headChanges, tipsetChangeTopic)
+
+		headChangeCount.Tick(c.ctx.lc)
+		recordHead(c.ctx.lc, int64(hc.ts.Height()), hc.ts.MinTimestamp())
 		return
 	}
 
@@ -136,7 +202,15 @@ func (c *Coordinator) handleCandidate(hc *headCandidate) {
 }
 
 func (c *Coordinator) applyTipSetChange(prev, next *types.TipSet, node *Node) ([]*api.HeadChange, error) {
-	revert, apply, err := store.ReorgOps(c.ctx.lc, node.loadTipSet, prev, next)
+	// nothing to revert before the first head
+	if prev == nil {
+		return []*api.HeadChange{{
+			Type: store.HCApply,
+			Val:  next,
+		}}, nil
+	}
+
+	revert, apply, err := store.ReorgOps(c.ctx.lc, c.tipSetLoader(node), prev, next)
 	if err != nil {
 		return nil, err
 	}
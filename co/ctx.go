@@ -19,6 +19,7 @@ func NewCtx(mctx helpers.MetricsCtx, lc fx.Lifecycle, nodeOpt NodeOption) (*Ctx,
 		headCh:    make(chan *headCandidate, 256),
 		errNodeCh: make(chan string, 256),
 		nodeOpt:   nodeOpt,
+		network:   newNetworkGuard(nodeOpt.Network),
 	}, nil
 }
 
@@ -29,6 +30,7 @@ type Ctx struct {
 	errNodeCh chan string
 
 	nodeOpt NodeOption
+	network *networkGuard
 }
 
 type headCandidate struct {
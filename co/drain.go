@@ -0,0 +1,70 @@
+package co
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// drainPollInterval is how often the calls in flight of a draining node are checked
+const drainPollInterval = 100 * time.Millisecond
+
+// Drain stops selecting the node for the new calls, the calls in flight go on.
+// Unlike Block, the weight of the node is kept, and it's selected again after Undrain.
+func (s *Selector) Drain(addr string) error {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if _, ok := s.priority[addr]; !ok {
+		return fmt.Errorf("node %s not found", addr)
+	}
+
+	s.draining[addr] = struct{}{}
+	log.Infof("drain node %s", addr)
+	return nil
+}
+
+// Undrain admits the node to be selected again
+func (s *Selector) Undrain(addr string) error {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if _, ok := s.draining[addr]; !ok {
+		return fmt.Errorf("node %s is not draining", addr)
+	}
+
+	delete(s.draining, addr)
+	log.Infof("undrain node %s", addr)
+	return nil
+}
+
+// Draining returns true if the node is draining
+func (s *Selector) Draining(addr string) bool {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	_, ok := s.draining[addr]
+	return ok
+}
+
+// InFlight returns the number of the calls in flight and queued on the node,
+// including the subscriptions, e.g. ChainNotify, until the node closes their channels.
+func (n *Node) InFlight() int64 {
+	return n.inFlight.Load() + n.subscriptions.Load()
+}
+
+// WaitIdle waits until there is no call in flight on the node, or the context is done
+func (n *Node) WaitIdle(ctx context.Context) error {
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+
+	for n.InFlight() > 0 {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%d calls still in flight on %s: %w", n.InFlight(), n.Addr, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+
+	return nil
+}
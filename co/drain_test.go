@@ -0,0 +1,101 @@
+package co
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/api/v1api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nodeStore := NewMockINodeStore(ctrl)
+
+	sel, _ := NewSelector(&Ctx{lc: context.Background()}, nodeStore, nil)
+	nodeStore.EXPECT().AddNodes(gomock.Any())
+	upstream := &blockingFullNode{entered: make(chan struct{}, 1), unblock: make(chan struct{})}
+	nodes := []*Node{{Addr: "a"}, {Addr: "b"}}
+	nodes[0].upstream.limited = nodes[0].wrapUpstream(upstream)
+	sel.AddNodes(nodes...)
+	nodeStore.EXPECT().GetNode(gomock.Any()).AnyTimes().DoAndReturn(func(arg0 string) *Node {
+		for _, node := range nodes {
+			if node.Addr == arg0 {
+				return node
+			}
+		}
+		return nil
+	})
+	sel.setPriority(CatchUpPriority, "a", "b")
+
+	// a call in flight on a
+	go nodes[0].FullNode().ChainHead(context.Background()) // nolint:errcheck
+	<-upstream.entered
+	assert.Equal(t, int64(1), nodes[0].InFlight())
+
+	assert.NoError(t, sel.Drain("a"))
+	assert.True(t, sel.Draining("a"))
+	for i := 0; i < 4; i++ {
+		node, err := sel.Select(context.Background(), types.EmptyTSK)
+		assert.NoError(t, err)
+		assert.Equal(t, "b", node.Addr)
+	}
+	assert.Len(t, sel.SelectN(context.Background(), types.EmptyTSK, 0, ErrPriority), 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, nodes[0].WaitIdle(ctx), context.DeadlineExceeded)
+
+	upstream.unblock <- struct{}{}
+	assert.NoError(t, nodes[0].WaitIdle(context.Background()))
+
+	assert.NoError(t, sel.Undrain("a"))
+	assert.Error(t, sel.Undrain("a"))
+	assert.Len(t, sel.SelectN(context.Background(), types.EmptyTSK, 0, ErrPriority), 2)
+	assert.Error(t, sel.Drain("c"))
+}
+
+type notifyFullNode struct {
+	v1api.FullNode
+	ch chan []*api.HeadChange
+}
+
+func (m *notifyFullNode) ChainNotify(context.Context) (<-chan []*api.HeadChange, error) {
+	return m.ch, nil
+}
+
+func TestDrainSubscription(t *testing.T) {
+	upstream := &notifyFullNode{ch: make(chan []*api.HeadChange, 1)}
+	node := &Node{Addr: "a"}
+	node.upstream.limited = node.wrapUpstream(upstream)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := node.FullNode().ChainNotify(ctx)
+	assert.NoError(t, err)
+
+	// the subscription is counted after the channel is returned
+	assert.Equal(t, int64(1), node.InFlight())
+	upstream.ch <- []*api.HeadChange{{Type: "current"}}
+	assert.Equal(t, "current", (<-ch)[0].Type)
+
+	waitCtx, waitCancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer waitCancel()
+	assert.ErrorIs(t, node.WaitIdle(waitCtx), context.DeadlineExceeded)
+
+	// the channel is closed for the caller once ctx is done, but the subscription lasts until the node closes it
+	cancel()
+	_, ok := <-ch
+	assert.False(t, ok)
+	assert.Equal(t, int64(1), node.InFlight())
+
+	close(upstream.ch)
+	assert.NoError(t, node.WaitIdle(context.Background()))
+	assert.Equal(t, int64(0), node.InFlight())
+}
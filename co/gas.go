@@ -0,0 +1,280 @@
+package co
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/big"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+// policies to combine the estimations from different nodes
+const (
+	AggregateMax    = "max"
+	AggregateMin    = "min"
+	AggregateMedian = "median"
+)
+
+// DefaultGasAggregateOption returns default options
+func DefaultGasAggregateOption() GasAggregateOption {
+	return GasAggregateOption{
+		Enable:           false,
+		Nodes:            3,
+		Timeout:          3 * time.Second,
+		GasLimitPolicy:   AggregateMax,
+		GasFeeCapPolicy:  AggregateMedian,
+		GasPremiumPolicy: AggregateMedian,
+	}
+}
+
+// GasAggregateOption is for aggregated gas estimation
+type GasAggregateOption struct {
+	// Enable fans out the estimations to multiple caught-up nodes
+	Enable bool
+	// Nodes is the max number of nodes to ask for each estimation
+	Nodes int
+	// Timeout is how long we wait for the nodes, nodes that do not respond in time are ignored
+	Timeout time.Duration
+
+	GasLimitPolicy   string
+	GasFeeCapPolicy  string
+	GasPremiumPolicy string
+}
+
+// NewGasEstimator constructs a GasEstimator instance
+func NewGasEstimator(opt GasAggregateOption, sel *Selector) (*GasEstimator, error) {
+	for _, policy := range []string{opt.GasLimitPolicy, opt.GasFeeCapPolicy, opt.GasPremiumPolicy} {
+		switch policy {
+		case AggregateMax, AggregateMin, AggregateMedian:
+		default:
+			return nil, fmt.Errorf("unknown gas aggregate policy %q", policy)
+		}
+	}
+
+	if opt.Enable && opt.Nodes < 1 {
+		return nil, fmt.Errorf("gas aggregate nodes must be greater than 0")
+	}
+
+	return &GasEstimator{
+		opt: opt,
+		sel: sel,
+	}, nil
+}
+
+// GasEstimator estimates the gas of messages with the views of multiple nodes
+type GasEstimator struct {
+	opt GasAggregateOption
+	sel *Selector
+}
+
+// GasEstimateMessageGas impls api.FullNode.GasEstimateMessageGas
+func (g *GasEstimator) GasEstimateMessageGas(ctx context.Context, msg *types.Message, spec *api.MessageSendSpec, tsk types.TipSetKey) (*types.Message, error) {
+	msgs, err := fanOutGas(ctx, g, tsk, "GasEstimateMessageGas", func(ctx context.Context, node *Node) (*types.Message, error) {
+		return node.FullNode().GasEstimateMessageGas(ctx, msg, spec, tsk)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return g.aggregateMessage(msgs, spec), nil
+}
+
+// GasEstimateFeeCap impls api.FullNode.GasEstimateFeeCap
+func (g *GasEstimator) GasEstimateFeeCap(ctx context.Context, msg *types.Message, maxqueueblks int64, tsk types.TipSetKey) (types.BigInt, error) {
+	feeCaps, err := fanOutGas(ctx, g, tsk, "GasEstimateFeeCap", func(ctx context.Context, node *Node) (types.BigInt, error) {
+		return node.FullNode().GasEstimateFeeCap(ctx, msg, maxqueueblks, tsk)
+	})
+	if err != nil {
+		return types.BigInt{}, err
+	}
+
+	return aggregateBigInt(g.opt.GasFeeCapPolicy, feeCaps), nil
+}
+
+// GasEstimateGasPremium impls api.FullNode.GasEstimateGasPremium
+func (g *GasEstimator) GasEstimateGasPremium(ctx context.Context, nblocksincl uint64, sender address.Address, gaslimit int64, tsk types.TipSetKey) (types.BigInt, error) {
+	premiums, err := fanOutGas(ctx, g, tsk, "GasEstimateGasPremium", func(ctx context.Context, node *Node) (types.BigInt, error) {
+		return node.FullNode().GasEstimateGasPremium(ctx, nblocksincl, sender, gaslimit, tsk)
+	})
+	if err != nil {
+		return types.BigInt{}, err
+	}
+
+	return aggregateBigInt(g.opt.GasPremiumPolicy, premiums), nil
+}
+
+// GasBatchEstimateMessageGas impls api.FullNode.GasBatchEstimateMessageGas
+func (g *GasEstimator) GasBatchEstimateMessageGas(ctx context.Context, estimateMessages []*api.EstimateMessage, fromNonce uint64, tsk types.TipSetKey) ([]*api.EstimateResult, error) {
+	batches, err := fanOutGas(ctx, g, tsk, "GasBatchEstimateMessageGas", func(ctx context.Context, node *Node) ([]*api.EstimateResult, error) {
+		return node.FullNode().GasBatchEstimateMessageGas(ctx, estimateMessages, fromNonce, tsk)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := batches[0]
+	for i := range out {
+		msgs := make([]*types.Message, 0, len(batches))
+		for _, batch := range batches {
+			if i < len(batch) && batch[i] != nil && batch[i].Err == "" && batch[i].Msg != nil {
+				msgs = append(msgs, batch[i].Msg)
+			}
+		}
+
+		if len(msgs) == 0 {
+			continue
+		}
+
+		var spec *api.MessageSendSpec
+		if i < len(estimateMessages) && estimateMessages[i] != nil {
+			spec = estimateMessages[i].Spec
+		}
+
+		out[i] = &api.EstimateResult{
+			Msg: g.aggregateMessage(msgs, spec),
+		}
+	}
+
+	return out, nil
+}
+
+func (g *GasEstimator) aggregateMessage(msgs []*types.Message, spec *api.MessageSendSpec) *types.Message {
+	limits := make([]int64, 0, len(msgs))
+	feeCaps := make([]types.BigInt, 0, len(msgs))
+	premiums := make([]types.BigInt, 0, len(msgs))
+	for _, msg := range msgs {
+		limits = append(limits, msg.GasLimit)
+		feeCaps = append(feeCaps, msg.GasFeeCap)
+		premiums = append(premiums, msg.GasPremium)
+	}
+
+	out := *msgs[0]
+	out.GasLimit = aggregateInt64(g.opt.GasLimitPolicy, limits)
+	out.GasFeeCap = aggregateBigInt(g.opt.GasFeeCapPolicy, feeCaps)
+	out.GasPremium = aggregateBigInt(g.opt.GasPremiumPolicy, premiums)
+	capGasFee(&out, spec)
+
+	return &out
+}
+
+// fanOutGas calls the estimation on the caught-up nodes and returns the results of the nodes respond in time.
+// It falls back to a single node when aggregation is disabled, or when none of the nodes respond in time.
+func fanOutGas[T any](ctx context.Context, g *GasEstimator, tsk types.TipSetKey, method string, call func(context.Context, *Node) (T, error)) ([]T, error) {
+	var nodes []*Node
+	if g.opt.Enable {
+		nodes = g.sel.SelectN(ctx, tsk, g.opt.Nodes, CatchUpPriority)
+	}
+
+	if len(nodes) > 1 {
+		callCtx, callCancel := context.WithTimeout(ctx, g.opt.Timeout)
+		defer callCancel()
+
+		type result struct {
+			addr string
+			val  T
+			err  error
+		}
+
+		resCh := make(chan result, len(nodes))
+		for _, node := range nodes {
+			go func(node *Node) {
+				val, err := call(callCtx, node)
+				resCh <- result{addr: node.Addr, val: val, err: err}
+			}(node)
+		}
+
+		var errs *multierror.Error
+		vals := make([]T, 0, len(nodes))
+		for range nodes {
+			res := <-resCh
+			if res.err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("%s: %w", res.addr, res.err))
+				continue
+			}
+
+			vals = append(vals, res.val)
+		}
+
+		if len(vals) > 0 {
+			if errs != nil {
+				log.Debugf("%s: aggregated %d of %d nodes, ignored: %s", method, len(vals), len(nodes), errs)
+			}
+			return vals, nil
+		}
+
+		log.Warnf("%s: none of %d nodes responded in time, fallback to a single node: %s", method, len(nodes), errs)
+	}
+
+	node, err := g.sel.Select(ctx, tsk)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := call(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+
+	return []T{val}, nil
+}
+
+func aggregateInt64(policy string, vals []int64) int64 {
+	sorted := append([]int64(nil), vals...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	switch policy {
+	case AggregateMin:
+		return sorted[0]
+	case AggregateMedian:
+		mid := len(sorted) / 2
+		if len(sorted)%2 == 0 {
+			return sorted[mid-1] + (sorted[mid]-sorted[mid-1])/2
+		}
+		return sorted[mid]
+	default:
+		return sorted[len(sorted)-1]
+	}
+}
+
+func aggregateBigInt(policy string, vals []types.BigInt) types.BigInt {
+	sorted := append([]types.BigInt(nil), vals...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LessThan(sorted[j])
+	})
+
+	switch policy {
+	case AggregateMin:
+		return sorted[0]
+	case AggregateMedian:
+		mid := len(sorted) / 2
+		if len(sorted)%2 == 0 {
+			return big.Div(big.Add(sorted[mid-1], sorted[mid]), big.NewInt(2))
+		}
+		return sorted[mid]
+	default:
+		return sorted[len(sorted)-1]
+	}
+}
+
+// capGasFee makes sure the aggregated message still respects the max fee of the send spec,
+// and that the premium never exceeds the fee cap.
+func capGasFee(msg *types.Message, spec *api.MessageSendSpec) {
+	if spec != nil && !spec.MaxFee.Nil() && !spec.MaxFee.IsZero() && msg.GasLimit > 0 {
+		gasLimit := types.NewInt(uint64(msg.GasLimit))
+		totalFee := big.Mul(msg.GasFeeCap, gasLimit)
+		if spec.MaximizeFeeCap || totalFee.GreaterThan(spec.MaxFee) {
+			msg.GasFeeCap = big.Div(spec.MaxFee, gasLimit)
+		}
+	}
+
+	msg.GasPremium = big.Min(msg.GasFeeCap, msg.GasPremium)
+}
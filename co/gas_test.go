@@ -0,0 +1,55 @@
+package co
+
+import (
+	"testing"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateInt64(t *testing.T) {
+	vals := []int64{30, 10, 20, 50}
+
+	assert.Equal(t, int64(50), aggregateInt64(AggregateMax, vals))
+	assert.Equal(t, int64(10), aggregateInt64(AggregateMin, vals))
+	assert.Equal(t, int64(25), aggregateInt64(AggregateMedian, vals))
+	assert.Equal(t, int64(20), aggregateInt64(AggregateMedian, vals[:3]))
+	// input should not be reordered
+	assert.Equal(t, []int64{30, 10, 20, 50}, vals)
+}
+
+func TestAggregateBigInt(t *testing.T) {
+	vals := []types.BigInt{types.NewInt(300), types.NewInt(100), types.NewInt(200)}
+
+	assert.Equal(t, types.NewInt(300), aggregateBigInt(AggregateMax, vals))
+	assert.Equal(t, types.NewInt(100), aggregateBigInt(AggregateMin, vals))
+	assert.Equal(t, types.NewInt(200), aggregateBigInt(AggregateMedian, vals))
+	assert.Equal(t, types.NewInt(200), aggregateBigInt(AggregateMedian, vals[:2]))
+}
+
+func TestAggregateMessage(t *testing.T) {
+	g, err := NewGasEstimator(DefaultGasAggregateOption(), nil)
+	assert.NoError(t, err)
+
+	msgs := []*types.Message{
+		{Nonce: 1, GasLimit: 100, GasFeeCap: types.NewInt(10), GasPremium: types.NewInt(3)},
+		{Nonce: 1, GasLimit: 300, GasFeeCap: types.NewInt(30), GasPremium: types.NewInt(5)},
+		{Nonce: 1, GasLimit: 200, GasFeeCap: types.NewInt(20), GasPremium: types.NewInt(40)},
+	}
+
+	out := g.aggregateMessage(msgs, nil)
+	assert.Equal(t, uint64(1), out.Nonce)
+	assert.Equal(t, int64(300), out.GasLimit)
+	assert.Equal(t, types.NewInt(20), out.GasFeeCap)
+	assert.Equal(t, types.NewInt(5), out.GasPremium)
+
+	// max fee of the spec caps the fee cap, and the premium follows the fee cap
+	out = g.aggregateMessage(msgs, &api.MessageSendSpec{MaxFee: types.NewInt(1200)})
+	assert.Equal(t, types.NewInt(4), out.GasFeeCap)
+	assert.Equal(t, types.NewInt(4), out.GasPremium)
+
+	_, err = NewGasEstimator(GasAggregateOption{GasLimitPolicy: "avg"}, nil)
+	assert.Error(t, err)
+}
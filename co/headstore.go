@@ -0,0 +1,165 @@
+package co
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/store"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+const (
+	// headWindow is the number of the recent tipsets kept by the coordinator
+	headWindow = 100
+	// headPersistInterval is how often the head is persisted if it has changed
+	headPersistInterval = 30 * time.Second
+)
+
+// HeadSnapshot is the persisted head of the coordinator
+type HeadSnapshot struct {
+	Weight types.BigInt
+	// TipSets are the recent tipsets, the last one is the head
+	TipSets []*types.TipSet
+}
+
+// Head returns the last tipset of the snapshot
+func (s *HeadSnapshot) Head() *types.TipSet {
+	if len(s.TipSets) == 0 {
+		return nil
+	}
+	return s.TipSets[len(s.TipSets)-1]
+}
+
+// NewHeadStore constructs a HeadStore instance, the head is not persisted if path is empty
+func NewHeadStore(path string) *HeadStore {
+	return &HeadStore{path: path}
+}
+
+// HeadStore reads and writes the HeadSnapshot in a file
+type HeadStore struct {
+	lk   sync.Mutex
+	path string
+}
+
+// Load returns nil if there is no snapshot
+func (hs *HeadStore) Load() (*HeadSnapshot, error) {
+	if hs == nil || hs.path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(hs.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot HeadSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("unmarshal head snapshot %s: %w", hs.path, err)
+	}
+	if snapshot.Head() == nil {
+		return nil, nil
+	}
+	return &snapshot, nil
+}
+
+// Save writes the snapshot to a temp file, and renames it, so that a crash never leaves a broken file
+func (hs *HeadStore) Save(snapshot *HeadSnapshot) error {
+	if hs == nil || hs.path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	hs.lk.Lock()
+	defer hs.lk.Unlock()
+
+	tmp := hs.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, hs.path)
+}
+
+// restoreHead starts from the snapshot if it's heavier than the head reported by the nodes
+func (c *Coordinator) restoreHead() {
+	snapshot, err := c.hs.Load()
+	if err != nil {
+		log.Warnf("load head snapshot: %s", err)
+		return
+	}
+	if snapshot == nil {
+		return
+	}
+
+	head := snapshot.Head()
+	if c.head != nil && !snapshot.Weight.GreaterThan(c.weight) {
+		log.Infof("ignore the persisted head %d, the head %d of the nodes is not lighter", head.Height(), c.head.Height())
+		return
+	}
+
+	log.Infof("restore the persisted head %d with %d recent tipsets", head.Height(), len(snapshot.TipSets))
+	c.head = head
+	c.weight = snapshot.Weight
+	c.recent = snapshot.TipSets
+}
+
+// persistHead saves the head if it has changed since the last save
+func (c *Coordinator) persistHead() {
+	c.headMu.Lock()
+	if !c.headDirty || c.head == nil {
+		c.headMu.Unlock()
+		return
+	}
+	snapshot := &HeadSnapshot{
+		Weight:  c.weight,
+		TipSets: append([]*types.TipSet{}, c.recent...),
+	}
+	c.headDirty = false
+	c.headMu.Unlock()
+
+	if err := c.hs.Save(snapshot); err != nil {
+		log.Warnf("persist head %d: %s", snapshot.Head().Height(), err)
+	}
+}
+
+// pushRecent applies the head changes to the recent tipsets, it must be called with headMu held
+func (c *Coordinator) pushRecent(changes []*api.HeadChange) {
+	for _, hc := range changes {
+		switch hc.Type {
+		case store.HCRevert:
+			if n := len(c.recent); n > 0 && c.recent[n-1].Equals(hc.Val) {
+				c.recent = c.recent[:n-1]
+			}
+		case store.HCApply:
+			c.recent = append(c.recent, hc.Val)
+		}
+	}
+
+	if over := len(c.recent) - headWindow; over > 0 {
+		c.recent = c.recent[over:]
+	}
+	c.headDirty = true
+}
+
+// tipSetLoader looks up the recent tipsets before asking the node, it must be called with headMu held
+func (c *Coordinator) tipSetLoader(node *Node) func(context.Context, types.TipSetKey) (*types.TipSet, error) {
+	return func(ctx context.Context, tsk types.TipSetKey) (*types.TipSet, error) {
+		for i := len(c.recent) - 1; i >= 0; i-- {
+			if c.recent[i].Key() == tsk {
+				return c.recent[i], nil
+			}
+		}
+		return node.loadTipSet(ctx, tsk)
+	}
+}
@@ -0,0 +1,101 @@
+package co
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/store"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeadStore(t *testing.T) {
+	hs := NewHeadStore(filepath.Join(t.TempDir(), "head.json"))
+
+	snapshot, err := hs.Load()
+	assert.NoError(t, err)
+	assert.Nil(t, snapshot)
+
+	tss := []*types.TipSet{genTipSet(t, 10), genTipSet(t, 11)}
+	assert.NoError(t, hs.Save(&HeadSnapshot{Weight: types.NewInt(100), TipSets: tss}))
+
+	snapshot, err = hs.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, types.NewInt(100), snapshot.Weight)
+	assert.Len(t, snapshot.TipSets, 2)
+	assert.Equal(t, tss[1].Key(), snapshot.Head().Key())
+
+	// nothing is persisted without a path
+	assert.NoError(t, NewHeadStore("").Save(snapshot))
+	snapshot, err = NewHeadStore("").Load()
+	assert.NoError(t, err)
+	assert.Nil(t, snapshot)
+}
+
+func TestCoordinatorRestoreHead(t *testing.T) {
+	hs := NewHeadStore(filepath.Join(t.TempDir(), "head.json"))
+	persisted := []*types.TipSet{genTipSet(t, 10), genTipSet(t, 11)}
+	assert.NoError(t, hs.Save(&HeadSnapshot{Weight: types.NewInt(100), TipSets: persisted}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// the node is behind the persisted head
+	c, err := NewCoordinator(&Ctx{lc: ctx}, genTipSet(t, 5), types.NewInt(50), nil, hs)
+	assert.NoError(t, err)
+	head, weight, _ := c.HeadState()
+	assert.Equal(t, persisted[1].Key(), head.Key())
+	assert.Equal(t, types.NewInt(100), weight)
+
+	// the reorg is computed from the recent tipsets
+	loaded, err := c.tipSetLoader(nil)(ctx, persisted[0].Key())
+	assert.NoError(t, err)
+	assert.Equal(t, persisted[0].Key(), loaded.Key())
+
+	// the node is ahead of the persisted head
+	ahead := genTipSet(t, 12)
+	c, err = NewCoordinator(&Ctx{lc: ctx}, ahead, types.NewInt(200), nil, hs)
+	assert.NoError(t, err)
+	head, _, _ = c.HeadState()
+	assert.Equal(t, ahead, head)
+
+	// the changed head is persisted on stop
+	next := genTipSet(t, 13)
+	c.headMu.Lock()
+	c.head, c.weight = next, types.NewInt(300)
+	c.pushRecent([]*api.HeadChange{{Type: store.HCApply, Val: next}})
+	c.headMu.Unlock()
+	assert.NoError(t, c.Stop())
+
+	snapshot, err := hs.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, types.NewInt(300), snapshot.Weight)
+	assert.Equal(t, []types.TipSetKey{ahead.Key(), next.Key()}, []types.TipSetKey{snapshot.TipSets[0].Key(), snapshot.TipSets[1].Key()})
+}
+
+func TestPushRecent(t *testing.T) {
+	c := &Coordinator{}
+	tss := make([]*types.TipSet, 0, headWindow+1)
+	for i := 0; i <= headWindow; i++ {
+		tss = append(tss, genTipSet(t, 1))
+	}
+
+	changes := make([]*api.HeadChange, 0, len(tss))
+	for _, ts := range tss {
+		changes = append(changes, &api.HeadChange{Type: store.HCApply, Val: ts})
+	}
+	c.pushRecent(changes)
+	assert.Len(t, c.recent, headWindow)
+	assert.Equal(t, tss[1], c.recent[0])
+
+	fork := genTipSet(t, 1)
+	c.pushRecent([]*api.HeadChange{
+		{Type: store.HCRevert, Val: tss[headWindow]},
+		{Type: store.HCApply, Val: fork},
+	})
+	assert.Len(t, c.recent, headWindow)
+	assert.Equal(t, tss[headWindow-1], c.recent[headWindow-2])
+	assert.Equal(t, fork, c.recent[headWindow-1])
+}
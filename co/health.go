@@ -0,0 +1,199 @@
+package co
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/etherlabsio/healthcheck/v2"
+	"github.com/filecoin-project/go-state-types/abi"
+)
+
+// health status
+const (
+	StatusOK          = "ok"
+	StatusUnavailable = "unavailable"
+)
+
+// names of the checkers
+const (
+	CheckerCaughtUp  = "caught_up"
+	CheckerHeadDrift = "head_drift"
+)
+
+// healthCheckTimeout limits the time of each checker
+const healthCheckTimeout = 5 * time.Second
+
+// DefaultHealthOption returns default options
+func DefaultHealthOption() HealthOption {
+	return HealthOption{
+		MinCaughtUp:  1,
+		MaxHeadDrift: 2 * time.Minute,
+	}
+}
+
+// HealthOption is the thresholds of the readiness
+type HealthOption struct {
+	// MinCaughtUp is the min number of the caught-up nodes
+	MinCaughtUp int
+	// MaxHeadDrift is the max duration between the wall clock and the timestamp of the head
+	MaxHeadDrift time.Duration
+}
+
+// NodeStatus is the status of an upstream node
+type NodeStatus struct {
+	Addr     string         `json:"addr"`
+	Labels   []string       `json:"labels,omitempty"`
+	Priority int            `json:"priority"`
+	Weight   int            `json:"weight"`
+	Height   abi.ChainEpoch `json:"height"`
+	Load     int64          `json:"load"`
+	FreeSlot bool           `json:"free_slot"`
+}
+
+// HealthStatus is the body of the health endpoints
+type HealthStatus struct {
+	Status string            `json:"status"`
+	Errors map[string]string `json:"errors,omitempty"`
+	Height abi.ChainEpoch    `json:"height"`
+	// HeadDrift is the seconds between the wall clock and the timestamp of the head
+	HeadDrift int64        `json:"head_drift"`
+	Nodes     []NodeStatus `json:"nodes"`
+}
+
+// NewHealth constructs a Health instance
+func NewHealth(opt HealthOption, c *Coordinator, sel *Selector) *Health {
+	return &Health{opt: opt, c: c, sel: sel}
+}
+
+// Health checks the coordinator and the upstream nodes
+type Health struct {
+	opt HealthOption
+	c   *Coordinator
+	sel *Selector
+}
+
+// CaughtUpChecker fails if the caught-up nodes are less than HealthOption.MinCaughtUp
+func (h *Health) CaughtUpChecker() healthcheck.Checker {
+	return healthcheck.CheckerFunc(func(context.Context) error {
+		caughtUp := len(h.sel.getAddrOfPriority(CatchUpPriority))
+		if caughtUp < h.opt.MinCaughtUp {
+			return fmt.Errorf("%d nodes caught up, expected at least %d", caughtUp, h.opt.MinCaughtUp)
+		}
+		return nil
+	})
+}
+
+// HeadDriftChecker fails if the head is older than HealthOption.MaxHeadDrift
+func (h *Health) HeadDriftChecker() healthcheck.Checker {
+	return healthcheck.CheckerFunc(func(ctx context.Context) error {
+		head, err := h.c.ChainHead(ctx)
+		if err != nil {
+			return err
+		}
+
+		drift := time.Since(time.Unix(int64(head.MinTimestamp()), 0))
+		if drift > h.opt.MaxHeadDrift {
+			return fmt.Errorf("head %d is %s behind the wall clock, expected at most %s", head.Height(), drift.Round(time.Second), h.opt.MaxHeadDrift)
+		}
+		return nil
+	})
+}
+
+// ReadyCheckers returns the checkers of the readiness
+func (h *Health) ReadyCheckers() map[string]healthcheck.Checker {
+	return map[string]healthcheck.Checker{
+		CheckerCaughtUp:  h.CaughtUpChecker(),
+		CheckerHeadDrift: h.HeadDriftChecker(),
+	}
+}
+
+// Status runs the checkers and collects the status of the nodes
+func (h *Health) Status(ctx context.Context, checkers map[string]healthcheck.Checker) *HealthStatus {
+	status := &HealthStatus{Status: StatusOK}
+
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	var lk sync.Mutex
+	var wg sync.WaitGroup
+	for name, checker := range checkers {
+		wg.Add(1)
+		go func(name string, checker healthcheck.Checker) {
+			defer wg.Done()
+
+			if err := checker.Check(ctx); err != nil {
+				lk.Lock()
+				defer lk.Unlock()
+
+				if status.Errors == nil {
+					status.Errors = make(map[string]string)
+				}
+				status.Errors[name] = err.Error()
+				status.Status = StatusUnavailable
+			}
+		}(name, checker)
+	}
+	wg.Wait()
+
+	if head, _ := h.c.ChainHead(ctx); head != nil {
+		status.Height = head.Height()
+		status.HeadDrift = time.Now().Unix() - int64(head.MinTimestamp())
+	}
+	status.Nodes = h.nodes()
+
+	return status
+}
+
+func (h *Health) nodes() []NodeStatus {
+	weights := h.sel.ListWeight()
+	priorities := h.sel.ListPriority()
+
+	nodes := make([]NodeStatus, 0, len(priorities))
+	for addr, priority := range priorities {
+		node := h.sel.nodeProvider.GetNode(addr)
+		if node == nil {
+			continue
+		}
+
+		ns := NodeStatus{
+			Addr:     addr,
+			Labels:   node.Labels,
+			Priority: priority,
+			Weight:   weights[addr],
+			Load:     node.Load(),
+			FreeSlot: node.hasFreeSlot(),
+		}
+		if head := node.Head(); head != nil {
+			ns.Height = head.Height()
+		}
+		nodes = append(nodes, ns)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Addr < nodes[j].Addr
+	})
+	return nodes
+}
+
+// Handler serves the HealthStatus with the checkers, the status code is 503 if any of them fails
+func (h *Health) Handler(checkers map[string]healthcheck.Checker) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := h.Status(r.Context(), checkers)
+
+		code := http.StatusOK
+		if status.Status != StatusOK {
+			code = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(code)
+		if err := json.NewEncoder(w).Encode(status); err != nil {
+			log.Warnf("write health status: %s", err)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package co
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/lotus/api/v1api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func genTipSetAt(t *testing.T, timestamp time.Time) *types.TipSet {
+	blk := genBlockHeader(t)
+	blk.Height = 100
+	blk.Timestamp = uint64(timestamp.Unix())
+
+	ts, err := types.NewTipSet([]*types.BlockHeader{blk})
+	assert.NoError(t, err)
+	return ts
+}
+
+func TestHealth(t *testing.T) {
+	c := setupCoordinator(t, genTipSetAt(t, time.Now()), map[string]v1api.FullNode{
+		"a": &mockFullNode{},
+		"b": &mockFullNode{},
+	})
+	h := NewHealth(HealthOption{MinCaughtUp: 2, MaxHeadDrift: time.Minute}, c, c.sel)
+
+	serve := func(handler http.Handler) (int, *HealthStatus) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+		var status HealthStatus
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &status))
+		return w.Code, &status
+	}
+
+	code, status := serve(h.Handler(h.ReadyCheckers()))
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, StatusOK, status.Status)
+	assert.Len(t, status.Nodes, 2)
+	assert.Equal(t, "a", status.Nodes[0].Addr)
+	assert.Equal(t, CatchUpPriority, status.Nodes[0].Priority)
+
+	// b is down, and the head is stale
+	c.sel.setPriority(ErrPriority, "b")
+	applyHead(c, genTipSetAt(t, time.Now().Add(-time.Hour)))
+
+	code, status = serve(h.Handler(h.ReadyCheckers()))
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, StatusUnavailable, status.Status)
+	assert.Contains(t, status.Errors, CheckerCaughtUp)
+	assert.Contains(t, status.Errors, CheckerHeadDrift)
+	assert.Equal(t, ErrPriority, status.Nodes[1].Priority)
+	assert.GreaterOrEqual(t, status.HeadDrift, int64(3600))
+
+	// the liveness doesn't depend on the upstreams
+	code, status = serve(h.Handler(nil))
+	assert.Equal(t, http.StatusOK, code)
+	assert.Len(t, status.Nodes, 2)
+}
@@ -0,0 +1,57 @@
+package co
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/ipfs-force-community/metrics"
+	"go.opencensus.io/tag"
+)
+
+var methodKey = tag.MustNewKey("method")
+
+// NetworkKey tags the metrics with the name of the network, which is set in the metrics ctx
+var NetworkKey = tag.MustNewKey("network")
+
+// metrics of the calls to the upstream nodes
+var (
+	nodeCallLatency = metrics.NewTimerMs("node_call_latency", "latency of the calls to the upstream node", nodeKey, methodKey)
+	nodeCallErrors  = metrics.NewCounter("node_call_errors", "calls to the upstream node which returned an error", nodeKey, methodKey)
+	nodeCallsFlight = metrics.NewInt64("node_calls_in_flight", "calls in flight and queued of the upstream node", "", nodeKey, methodKey)
+)
+
+// metrics of the coordinator
+var (
+	headHeight      = metrics.NewInt64("head_height", "height of the head chosen by the coordinator", "", NetworkKey)
+	headDrift       = metrics.NewInt64("head_drift", "seconds between the wall clock and the timestamp of the head", "s", NetworkKey)
+	headChangeCount = metrics.NewCounter("head_changes", "times the head is replaced by a heavier one", NetworkKey)
+	caughtUpNodes   = metrics.NewInt64("caught_up_nodes", "number of the nodes which have caught up with the head", "", NetworkKey)
+)
+
+// headDriftInterval is how often head_drift is recorded, since it grows between the head changes
+const headDriftInterval = 5 * time.Second
+
+// callMetrics records the metrics of a call of the method to the node,
+// the returned func should be called with the error of the call when it returns.
+func (n *Node) callMetrics(ctx context.Context, method string) func(error) {
+	mctx, _ := tag.New(ctx, tag.Upsert(nodeKey, n.Addr), tag.Upsert(methodKey, method))
+
+	v, _ := n.calls.LoadOrStore(method, new(atomic.Int64))
+	inFlight := v.(*atomic.Int64)
+	nodeCallsFlight.Set(mctx, inFlight.Add(1))
+	stopWatch := nodeCallLatency.Start()
+
+	return func(err error) {
+		stopWatch(mctx)
+		nodeCallsFlight.Set(mctx, inFlight.Add(-1))
+		if err != nil {
+			nodeCallErrors.Tick(mctx)
+		}
+	}
+}
+
+func recordHead(ctx context.Context, height int64, timestamp uint64) {
+	headHeight.Set(ctx, height)
+	headDrift.Set(ctx, time.Now().Unix()-int64(timestamp))
+}
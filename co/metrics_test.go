@@ -0,0 +1,107 @@
+package co
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/filecoin-project/lotus/api/v1api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+type failingFullNode struct {
+	v1api.FullNode
+}
+
+func (f *failingFullNode) ChainHead(context.Context) (*types.TipSet, error) {
+	return nil, fmt.Errorf("mock error")
+}
+
+func TestCallMetrics(t *testing.T) {
+	node := &Node{Addr: "metrics-node"}
+	full := node.wrapUpstream(&failingFullNode{})
+
+	var served []string
+	ctx := WithNodeRecorder(context.Background(), func(addr string) { served = append(served, addr) })
+	for i := 0; i < 2; i++ {
+		_, err := full.ChainHead(ctx)
+		assert.Error(t, err)
+	}
+	assert.Equal(t, []string{"metrics-node", "metrics-node"}, served)
+
+	rowOf := func(name string) *view.Row {
+		rows, err := view.RetrieveData(name)
+		assert.NoError(t, err)
+		for _, row := range rows {
+			tags := map[string]string{}
+			for _, tg := range row.Tags {
+				tags[tg.Key.Name()] = tg.Value
+			}
+			if tags["node"] == "metrics-node" && tags["method"] == "ChainHead" {
+				return row
+			}
+		}
+		return nil
+	}
+
+	errRow := rowOf("node_call_errors")
+	assert.NotNil(t, errRow)
+	assert.Equal(t, int64(2), errRow.Data.(*view.CountData).Value)
+
+	latencyRow := rowOf("node_call_latency")
+	assert.NotNil(t, latencyRow)
+	assert.Equal(t, int64(2), latencyRow.Data.(*view.DistributionData).Count)
+
+	inFlightRow := rowOf("node_calls_in_flight")
+	assert.NotNil(t, inFlightRow)
+	assert.Equal(t, float64(0), inFlightRow.Data.(*view.LastValueData).Value)
+}
+
+func TestPriorityMetricsOfNetworks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// the selectors of two networks in one process
+	for network, caughtUp := range map[string][]string{"mainnet": {"m1", "m2"}, "calibnet": {"c1"}} {
+		ctx, err := tag.New(context.Background(), tag.Upsert(NetworkKey, network))
+		assert.NoError(t, err)
+
+		nodeStore := NewMockINodeStore(ctrl)
+		nodeStore.EXPECT().AddNodes(gomock.Any())
+		sel, _ := NewSelector(&Ctx{lc: ctx}, nodeStore, nil)
+		nodes := make([]*Node, 0, len(caughtUp))
+		for _, addr := range caughtUp {
+			nodes = append(nodes, &Node{Addr: addr})
+		}
+		sel.AddNodes(nodes...)
+		sel.setPriority(CatchUpPriority, caughtUp...)
+	}
+
+	rowsOf := func(name string) map[string][]*view.Row {
+		rows, err := view.RetrieveData(name)
+		assert.NoError(t, err)
+		ret := map[string][]*view.Row{}
+		for _, row := range rows {
+			for _, tg := range row.Tags {
+				if tg.Key == NetworkKey {
+					ret[tg.Value] = append(ret[tg.Value], row)
+				}
+			}
+		}
+		return ret
+	}
+
+	caughtUpRows := rowsOf("caught_up_nodes")
+	assert.Len(t, caughtUpRows["mainnet"], 1)
+	assert.Equal(t, float64(2), caughtUpRows["mainnet"][0].Data.(*view.LastValueData).Value)
+	assert.Len(t, caughtUpRows["calibnet"], 1)
+	assert.Equal(t, float64(1), caughtUpRows["calibnet"][0].Data.(*view.LastValueData).Value)
+
+	priorityRows := rowsOf("node_priority")
+	assert.Len(t, priorityRows["mainnet"], 2)
+	assert.Len(t, priorityRows["calibnet"], 1)
+}
@@ -0,0 +1,175 @@
+package co
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-state-types/network"
+	"github.com/ipfs/go-cid"
+
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+// networkCheckInterval is how often the network and the capabilities of a connected node are checked again
+const networkCheckInterval = 5 * time.Minute
+
+// ErrNetworkMismatch is returned when the node is not on the same network as the others
+var ErrNetworkMismatch = fmt.Errorf("network mismatch")
+
+// NetworkIdentity identifies the network of a node
+type NetworkIdentity struct {
+	Genesis cid.Cid
+	Name    string
+	Version network.Version
+}
+
+func newNetworkGuard(expected string) *networkGuard {
+	return &networkGuard{expected: expected}
+}
+
+// networkGuard checks the nodes against the expected network name and the identity of the first checked node
+type networkGuard struct {
+	expected string
+
+	lk      sync.Mutex
+	ref     *NetworkIdentity
+	refAddr string
+}
+
+// check returns ErrNetworkMismatch if the node is on another network, or it misses a network upgrade.
+// The reference version is raised when a node on the same network reports a newer one.
+func (g *networkGuard) check(addr string, id NetworkIdentity) error {
+	if g == nil {
+		return nil
+	}
+
+	if g.expected != "" && id.Name != g.expected {
+		return fmt.Errorf("%w: network name is %s, expected %s", ErrNetworkMismatch, id.Name, g.expected)
+	}
+
+	g.lk.Lock()
+	defer g.lk.Unlock()
+
+	if g.ref == nil {
+		ref := id
+		g.ref = &ref
+		g.refAddr = addr
+		log.Infof("network of the nodes: %s, genesis %s, version %d, checked with node %s", id.Name, id.Genesis, id.Version, addr)
+		return nil
+	}
+
+	if !id.Genesis.Equals(g.ref.Genesis) {
+		return fmt.Errorf("%w: genesis is %s, expected %s of node %s", ErrNetworkMismatch, id.Genesis, g.ref.Genesis, g.refAddr)
+	}
+	if id.Name != g.ref.Name {
+		return fmt.Errorf("%w: network name is %s, expected %s of node %s", ErrNetworkMismatch, id.Name, g.ref.Name, g.refAddr)
+	}
+	if id.Version < g.ref.Version {
+		return fmt.Errorf("%w: network version is %d, expected %d of node %s", ErrNetworkMismatch, id.Version, g.ref.Version, g.refAddr)
+	}
+	if id.Version > g.ref.Version {
+		log.Infof("network version is upgraded from %d to %d by node %s", g.ref.Version, id.Version, addr)
+		g.ref.Version = id.Version
+		g.refAddr = addr
+	}
+	return nil
+}
+
+// Refused returns true if the node is on another network, it's never selected then
+func (n *Node) Refused() bool {
+	return n.refused.Load()
+}
+
+// CheckNetwork gets the identity of the node and checks it, the node is refused if it mismatches.
+// Errors of the calls don't change whether the node is refused.
+func (n *Node) CheckNetwork() error {
+	id, err := n.networkIdentity()
+	if err != nil {
+		return fmt.Errorf("get network identity: %w", err)
+	}
+
+	err = n.sctx.network.check(n.Addr, id)
+
+	n.state.lk.Lock()
+	n.state.Network = id
+	n.state.lk.Unlock()
+
+	if err != nil {
+		if !n.refused.Swap(true) {
+			n.log.Errorf("REFUSED, the node is excluded until it's on the same network: %s", err)
+		}
+		return err
+	}
+
+	if n.refused.Swap(false) {
+		n.log.Warnf("the node is on the same network now: %s, version %d", id.Name, id.Version)
+	}
+	return nil
+}
+
+func (n *Node) networkIdentity() (NetworkIdentity, error) {
+	ctx, cancel := context.WithTimeout(n.ctx, n.opt.APITimeout)
+	defer cancel()
+
+	full := n.rawFullNode()
+	genesis, err := full.ChainGetGenesis(ctx)
+	if err != nil {
+		return NetworkIdentity{}, err
+	}
+
+	name, err := full.StateNetworkName(ctx)
+	if err != nil {
+		return NetworkIdentity{}, err
+	}
+
+	ver, err := full.StateNetworkVersion(ctx, types.EmptyTSK)
+	if err != nil {
+		return NetworkIdentity{}, err
+	}
+
+	return NetworkIdentity{
+		Genesis: genesis.Cids()[0],
+		Name:    string(name),
+		Version: ver,
+	}, nil
+}
+
+// checkLoop checks the network and the capabilities of the connected node periodically until ctx is done.
+// It runs apart from the head change loop, so that the head changes are still read while a slow node is checked.
+func (n *Node) checkLoop(ctx context.Context) {
+	ticker := time.NewTicker(networkCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			n.recheckNetwork()
+			n.discoverCapabilities()
+		}
+	}
+}
+
+// recheckNetwork checks the network of the connected node, the node is reported as failed if it's refused
+func (n *Node) recheckNetwork() {
+	err := n.CheckNetwork()
+	if err == nil {
+		return
+	}
+
+	if !errors.Is(err, ErrNetworkMismatch) {
+		n.log.Warnf("check network: %s", err)
+		return
+	}
+
+	n.setConnected(true, err)
+	select {
+	case n.sctx.errNodeCh <- n.info.Addr:
+	case <-n.ctx.Done():
+	}
+}
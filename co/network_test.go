@@ -0,0 +1,99 @@
+package co
+
+import (
+	"context"
+	"testing"
+
+	"github.com/filecoin-project/go-state-types/network"
+	"github.com/filecoin-project/lotus/api/v1api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/node/modules/dtypes"
+	"github.com/stretchr/testify/assert"
+)
+
+type networkFullNode struct {
+	v1api.FullNode
+	genesis *types.TipSet
+	name    string
+	version network.Version
+}
+
+func (m *networkFullNode) ChainGetGenesis(context.Context) (*types.TipSet, error) {
+	return m.genesis, nil
+}
+
+func (m *networkFullNode) StateNetworkName(context.Context) (dtypes.NetworkName, error) {
+	return dtypes.NetworkName(m.name), nil
+}
+
+func (m *networkFullNode) StateNetworkVersion(context.Context, types.TipSetKey) (network.Version, error) {
+	return m.version, nil
+}
+
+func TestNetworkGuard(t *testing.T) {
+	mainnet := NetworkIdentity{Genesis: genTipSet(t, 0).Cids()[0], Name: "testnetnet", Version: network.Version21}
+	calibnet := NetworkIdentity{Genesis: genTipSet(t, 0).Cids()[0], Name: "calibrationnet", Version: network.Version21}
+
+	// the first node is the reference
+	g := newNetworkGuard("")
+	assert.NoError(t, g.check("a", mainnet))
+	assert.NoError(t, g.check("b", mainnet))
+	assert.ErrorIs(t, g.check("c", calibnet), ErrNetworkMismatch)
+
+	renamed := mainnet
+	renamed.Name = "calibrationnet"
+	assert.ErrorIs(t, g.check("c", renamed), ErrNetworkMismatch)
+
+	// the version is raised by the upgraded node, the others have to catch up
+	upgraded := mainnet
+	upgraded.Version = network.Version22
+	assert.NoError(t, g.check("a", upgraded))
+	assert.ErrorIs(t, g.check("b", mainnet), ErrNetworkMismatch)
+	assert.NoError(t, g.check("b", upgraded))
+
+	// the expected network is configured
+	g = newNetworkGuard("calibrationnet")
+	assert.ErrorIs(t, g.check("a", mainnet), ErrNetworkMismatch)
+	assert.NoError(t, g.check("b", calibnet))
+
+	var nilGuard *networkGuard
+	assert.NoError(t, nilGuard.check("a", calibnet))
+}
+
+func TestCheckNetwork(t *testing.T) {
+	genesis := genTipSet(t, 0)
+	mainnet := &networkFullNode{genesis: genesis, name: "testnetnet", version: network.Version21}
+	c := setupCoordinator(t, genTipSet(t, 10), map[string]v1api.FullNode{
+		"a": mainnet,
+		"b": &networkFullNode{genesis: genTipSet(t, 0), name: "calibrationnet", version: network.Version21},
+	})
+
+	sctx := &Ctx{lc: context.Background(), errNodeCh: make(chan string, 1), network: newNetworkGuard("")}
+	for _, addr := range []string{"a", "b"} {
+		node := c.sel.Node(addr)
+		node.ctx, node.sctx, node.opt, node.log = sctx.lc, sctx, DefaultNodeOption(), log.With("remote", addr)
+		node.info.Addr = addr
+	}
+
+	a, b := c.sel.Node("a"), c.sel.Node("b")
+	assert.NoError(t, a.CheckNetwork())
+	assert.ErrorIs(t, b.CheckNetwork(), ErrNetworkMismatch)
+	assert.False(t, a.Refused())
+	assert.True(t, b.Refused())
+	assert.Equal(t, "calibrationnet", b.ConnState().Network.Name)
+
+	for i := 0; i < 4; i++ {
+		node, err := c.sel.Select(context.Background(), types.EmptyTSK)
+		assert.NoError(t, err)
+		assert.Equal(t, "a", node.Addr)
+	}
+
+	// the refused node is reported as failed
+	b.recheckNetwork()
+	assert.Equal(t, "b", <-sctx.errNodeCh)
+
+	// the node is back after it joins the network
+	b.upstream.full = mainnet
+	assert.NoError(t, b.CheckNetwork())
+	assert.False(t, b.Refused())
+}
@@ -2,11 +2,15 @@ package co
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/filecoin-project/lotus/api/v1api"
 	vapi "github.com/filecoin-project/venus/venus-shared/api"
+	v1 "github.com/filecoin-project/venus/venus-shared/api/chain/v1"
 
 	"github.com/filecoin-project/go-jsonrpc"
 	"github.com/hashicorp/go-multierror"
@@ -18,8 +22,15 @@ import (
 	"github.com/filecoin-project/lotus/api/client"
 	"github.com/filecoin-project/lotus/chain/store"
 	"github.com/filecoin-project/lotus/chain/types"
+
+	"github.com/ipfs-force-community/sophon-co/cost"
 )
 
+var errHeadChangeClosed = fmt.Errorf("head change channel closed")
+
+// latencySmoothing is the reciprocal of the weight of each call in the moving average of the latency
+const latencySmoothing = 8
+
 // NodeInfoList is a type def for dependency injection
 type NodeInfoList []NodeInfo
 
@@ -29,6 +40,10 @@ func DefaultNodeOption() NodeOption {
 		ReListenMinInterval: 4 * time.Second,
 		ReListenMaxInterval: 32 * time.Second,
 		APITimeout:          10 * time.Second,
+		MaxInFlight:         0,
+		QueueSize:           256,
+		QueueTimeout:        10 * time.Second,
+		Costs:               cost.New(nil),
 	}
 }
 
@@ -38,26 +53,48 @@ type NodeOption struct {
 	ReListenMaxInterval time.Duration
 
 	APITimeout time.Duration
+
+	// MaxInFlight limits the calls in flight of each node, 0 means unlimited.
+	// Calls beyond the limit wait in a queue of QueueSize for at most QueueTimeout.
+	MaxInFlight  int
+	QueueSize    int
+	QueueTimeout time.Duration
+
+	// Costs weights the calls when balancing the load between the nodes, nil counts every call as 1
+	Costs *cost.Table
+
+	// Network is the expected network name of the nodes, the network of the first connected node is used if empty
+	Network string
 }
 
 // NodeInfo is a type combine cliutil.APIInfo and protocol version
 type NodeInfo struct {
 	vapi.APIInfo
 	Version string
+	// Name is a friendly name of the node
+	Name string
+	// Labels are the pools the node belongs to
+	Labels []string
+	// MaxInFlight overrides NodeOption.MaxInFlight if positive
+	MaxInFlight int
 }
 
-func NewNodeInfo(addr string, version string) NodeInfo {
+// NewNodeInfo parses the addr in the format of token:multiaddr, the node is in the pools of the labels
+func NewNodeInfo(addr string, version string, labels ...string) NodeInfo {
 	return NodeInfo{
 		APIInfo: vapi.ParseApiInfo(addr),
 		Version: version,
+		Labels:  labels,
 	}
 }
 
 // Node is a FullNode client
 type Node struct {
-	opt  NodeOption
-	info NodeInfo
-	Addr string
+	opt    NodeOption
+	info   NodeInfo
+	Addr   string
+	Name   string
+	Labels []string
 
 	reListenInterval time.Duration
 
@@ -66,10 +103,40 @@ type Node struct {
 
 	sctx *Ctx
 
-	upstream struct {
+	upstreamLk sync.RWMutex
+	upstream   struct {
 		full   v1api.FullNode
 		closer jsonrpc.ClientCloser
+		// limited is the client used to serve the requests, see wrapUpstream
+		limited v1api.FullNode
+		// venus is the client speaking the venus api, which is dialed for the venus nodes only
+		venus struct {
+			closer  jsonrpc.ClientCloser
+			limited v1.FullNode
+		}
+	}
+
+	slots   chan struct{}
+	waiting atomic.Int64
+	// load is the cost of the calls in flight and queued
+	load atomic.Int64
+	// inFlight is the number of the calls in flight and queued
+	inFlight atomic.Int64
+	// subscriptions is the number of the channels returned by the node and not closed yet, see trackSubscription
+	subscriptions atomic.Int64
+	// calls is the number of the calls in flight and queued of each method
+	calls sync.Map
+	// head is the latest head of the node
+	head atomic.Pointer[types.TipSet]
+
+	state struct {
+		lk sync.Mutex
+		NodeConnState
 	}
+	// latency is the moving average of the latency of the calls in nanoseconds
+	latency atomic.Int64
+	// refused is true if the node is on another network, see CheckNetwork
+	refused atomic.Bool
 
 	blkCache *blockHeaderCache
 
@@ -88,7 +155,17 @@ func NewNode(cctx *Ctx, info NodeInfo) (*Node, error) {
 		return nil, err
 	}
 
+	var slots chan struct{}
+	maxInFlight := cctx.nodeOpt.MaxInFlight
+	if info.MaxInFlight > 0 {
+		maxInFlight = info.MaxInFlight
+	}
+	if maxInFlight > 0 {
+		slots = make(chan struct{}, maxInFlight)
+	}
+
 	return &Node{
+		slots:            slots,
 		reListenInterval: cctx.nodeOpt.ReListenMinInterval,
 		opt:              cctx.nodeOpt,
 		info:             info,
@@ -96,26 +173,71 @@ func NewNode(cctx *Ctx, info NodeInfo) (*Node, error) {
 		cancel:           cancel,
 		sctx:             cctx,
 		Addr:             info.Addr,
+		Name:             info.Name,
+		Labels:           info.Labels,
 		blkCache:         blkCache,
 		log:              log.With("remote", addr),
 	}, nil
 }
 
 func (n *Node) Connect() error {
+	_, err := n.connect()
+	return err
+}
+
+// Reconnect drops the current client and connects to the node again, the current one is kept if it fails.
+// The head change loop listens on the new client after the channel of the current one is closed.
+func (n *Node) Reconnect() error {
+	closer, err := n.connect()
+	if err != nil {
+		return err
+	}
+
+	if closer != nil {
+		closer()
+	}
+
+	if n.VenusFullNode() != nil {
+		closer, err = n.connectVenus()
+		if err != nil {
+			n.log.Warnf("reconnect with the venus api: %s", err)
+		} else if closer != nil {
+			closer()
+		}
+	}
+	n.log.Info("reconnected")
+	return nil
+}
+
+// connect dials the node and replaces the client, the closer of the replaced one is returned
+func (n *Node) connect() (jsonrpc.ClientCloser, error) {
 	info := n.info
 	addr, err := info.DialArgs(info.Version)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	full, closer, err := client.NewFullNodeRPCV1(n.ctx, addr, info.AuthHeader())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	n.upstreamLk.Lock()
+	defer n.upstreamLk.Unlock()
+
+	prev := n.upstream.closer
 	n.upstream.full = full
 	n.upstream.closer = closer
-	return nil
+	n.upstream.limited = n.wrapUpstream(full)
+	return prev, nil
+}
+
+// rawFullNode returns the client without the wrappers, which is used by the head change loop
+func (n *Node) rawFullNode() v1api.FullNode {
+	n.upstreamLk.RLock()
+	defer n.upstreamLk.RUnlock()
+
+	return n.upstream.full
 }
 
 // Start starts a head change loop
@@ -133,6 +255,7 @@ func (n *Node) Start() {
 		}
 
 		chLifeCtx, chLifeCancel := context.WithCancel(n.ctx)
+		go n.checkLoop(chLifeCtx)
 
 	CHANGES_LOOP:
 		for {
@@ -151,41 +274,149 @@ func (n *Node) Start() {
 		}
 
 		chLifeCancel()
+		n.setConnected(false, errHeadChangeClosed)
 	}
 }
 
 // Stop closes current node
 func (n *Node) Stop() error {
 	n.cancel()
-	if n.upstream.closer != nil {
-		n.upstream.closer()
+
+	n.upstreamLk.RLock()
+	closer := n.upstream.closer
+	venusCloser := n.upstream.venus.closer
+	n.upstreamLk.RUnlock()
+	if closer != nil {
+		closer()
+	}
+	if venusCloser != nil {
+		venusCloser()
 	}
 	return nil
 }
 
-// FullNode returns the client to the upstream node
+// FullNode returns the client to the upstream node, which is limited by the slots of the node
 func (n *Node) FullNode() v1api.FullNode {
+	n.upstreamLk.RLock()
+	defer n.upstreamLk.RUnlock()
+
+	if n.upstream.limited != nil {
+		return n.upstream.limited
+	}
 	return n.upstream.full
 }
 
+// Head returns the latest head of the node, nil if no head change is received yet
+func (n *Node) Head() *types.TipSet {
+	return n.head.Load()
+}
+
+// NodeConnState is the state of the connection to the node
+type NodeConnState struct {
+	Connected   bool
+	LastError   string
+	LastErrorAt time.Time
+	// Version is the version of the node got after it's connected
+	Version string
+	// HeadAt is the time when the latest head is received
+	HeadAt     time.Time
+	HeadWeight types.BigInt
+	// Network is the network of the node got after it's connected
+	Network NetworkIdentity
+	// Capabilities are discovered after the node is connected
+	Capabilities Capabilities
+}
+
+// ConnState returns the state of the connection to the node
+func (n *Node) ConnState() NodeConnState {
+	n.state.lk.Lock()
+	defer n.state.lk.Unlock()
+
+	return n.state.NodeConnState
+}
+
+func (n *Node) setConnected(connected bool, err error) {
+	n.state.lk.Lock()
+	defer n.state.lk.Unlock()
+
+	n.state.Connected = connected
+	if err != nil {
+		n.state.LastError = err.Error()
+		n.state.LastErrorAt = time.Now()
+	}
+}
+
+func (n *Node) updateVersion() {
+	ctx, cancel := context.WithTimeout(n.ctx, n.opt.APITimeout)
+	defer cancel()
+
+	ver, err := n.rawFullNode().Version(ctx)
+	if err != nil {
+		n.log.Warnf("call Version: %s", err)
+		return
+	}
+
+	n.state.lk.Lock()
+	defer n.state.lk.Unlock()
+	n.state.Version = ver.Version
+}
+
+// Latency returns the moving average of the latency of the calls to the node
+func (n *Node) Latency() time.Duration {
+	return time.Duration(n.latency.Load())
+}
+
+// observeLatency adds the latency of a call to the moving average
+func (n *Node) observeLatency(d time.Duration) {
+	for {
+		prev := n.latency.Load()
+		next := int64(d)
+		if prev > 0 {
+			next = prev + (int64(d)-prev)/latencySmoothing
+		}
+		if n.latency.CompareAndSwap(prev, next) {
+			return
+		}
+	}
+}
+
+func (n *Node) setHead(ts *types.TipSet, weight types.BigInt) {
+	n.head.Store(ts)
+
+	n.state.lk.Lock()
+	defer n.state.lk.Unlock()
+
+	n.state.HeadAt = time.Now()
+	n.state.HeadWeight = weight
+}
+
 func (n *Node) reListen() (<-chan []*api.HeadChange, error) {
 	for {
 		var err error
 		var ch <-chan []*api.HeadChange
 		// if full node client is nil, try reconnect
-		if n.upstream.full == nil {
+		if n.rawFullNode() == nil {
 			err = n.Connect()
+			if err != nil {
+				n.log.Errorf("failed to connect to upstream node: %s", err)
+			}
+		}
+		// the mismatch is logged in CheckNetwork
+		if err == nil {
+			err = n.CheckNetwork()
+			if err != nil && !errors.Is(err, ErrNetworkMismatch) {
+				n.log.Errorf("failed to check network: %s", err)
+			}
 		}
 		if err == nil {
-			ch, err = n.upstream.full.ChainNotify(n.ctx)
+			ch, err = n.rawFullNode().ChainNotify(n.ctx)
 			if err != nil {
 				n.log.Errorf("call ChainNotify fail: %s", err)
 			}
-		} else {
-			n.log.Errorf("failed to connect to upstream node: %s", err)
 		}
 
 		if err != nil {
+			n.setConnected(false, err)
 			n.log.Infof("retry after %s", n.reListenInterval)
 			n.sctx.errNodeCh <- n.info.Addr
 
@@ -204,6 +435,9 @@ func (n *Node) reListen() (<-chan []*api.HeadChange, error) {
 			continue
 		}
 
+		n.setConnected(true, nil)
+		n.updateVersion()
+		n.discoverCapabilities()
 		n.reListenInterval = n.opt.ReListenMinInterval
 		return ch, nil
 	}
@@ -229,11 +463,16 @@ func (n *Node) applyChanges(lifeCtx context.Context, changes []*api.HeadChange)
 	ts := changes[idx].Val
 
 	callCtx, callCancel := context.WithTimeout(lifeCtx, n.opt.APITimeout)
-	weight, err := n.upstream.full.ChainTipSetWeight(callCtx, ts.Key())
+	weight, err := n.rawFullNode().ChainTipSetWeight(callCtx, ts.Key())
 	callCancel()
 
 	if err != nil {
 		n.log.Errorf("call ChainTipSetWeight: %s", err)
+		n.setConnected(true, fmt.Errorf("call ChainTipSetWeight: %w", err))
+		return
+	}
+	n.setHead(ts, weight)
+	if n.Refused() {
 		return
 	}
 
@@ -294,7 +533,7 @@ func (n *Node) loadBlockHeader(ctx context.Context, c cid.Cid) (*types.BlockHead
 		return blk, nil
 	}
 
-	blk, err := n.upstream.full.ChainGetBlock(ctx, c)
+	blk, err := n.rawFullNode().ChainGetBlock(ctx, c)
 	return blk, err
 }
 
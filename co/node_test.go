@@ -0,0 +1,97 @@
+package co
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/api/v1api"
+	"github.com/filecoin-project/lotus/chain/store"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeConnState(t *testing.T) {
+	node := &Node{Addr: "a"}
+	assert.Nil(t, node.Head())
+	assert.False(t, node.ConnState().Connected)
+
+	node.setConnected(false, fmt.Errorf("dial refused"))
+	state := node.ConnState()
+	assert.False(t, state.Connected)
+	assert.Equal(t, "dial refused", state.LastError)
+	assert.False(t, state.LastErrorAt.IsZero())
+
+	// the last error is kept after the node is connected
+	node.setConnected(true, nil)
+	ts := genTipSet(t, 10)
+	node.setHead(ts, types.NewInt(100))
+	state = node.ConnState()
+	assert.True(t, state.Connected)
+	assert.Equal(t, "dial refused", state.LastError)
+	assert.Equal(t, types.NewInt(100), state.HeadWeight)
+	assert.False(t, state.HeadAt.IsZero())
+	assert.Equal(t, ts, node.Head())
+}
+
+func TestCoordinatorHeadState(t *testing.T) {
+	head := genTipSet(t, 10)
+	c := setupCoordinator(t, head, map[string]v1api.FullNode{"a": &mockFullNode{}})
+	c.nodes = append(c.nodes, "a")
+
+	ts, weight, nodes := c.HeadState()
+	assert.Equal(t, head, ts)
+	assert.Equal(t, types.NewInt(0), weight)
+	assert.Equal(t, []string{"a"}, nodes)
+	assert.NotNil(t, c.sel.Node("a"))
+	assert.Nil(t, c.sel.Node("b"))
+}
+
+func TestCoordinatorDegraded(t *testing.T) {
+	c := setupCoordinator(t, genTipSetAt(t, time.Now()), map[string]v1api.FullNode{"a": &mockFullNode{}})
+	// no node is reachable at startup
+	c.head = nil
+	h := NewHealth(DefaultHealthOption(), c, c.sel)
+
+	ctx := context.Background()
+	_, err := c.ChainHead(ctx)
+	assert.ErrorIs(t, err, ErrNoHead)
+	_, err = c.ChainNotify(ctx)
+	assert.ErrorIs(t, err, ErrNoHead)
+	_, err = c.StateWaitMsg(ctx, genTipSet(t, 1).Blocks()[0].Cid(), 0, -1, true)
+	assert.ErrorIs(t, err, ErrNoHead)
+
+	status := h.Status(ctx, h.ReadyCheckers())
+	assert.Equal(t, StatusUnavailable, status.Status)
+	assert.Equal(t, ErrNoHead.Error(), status.Errors[CheckerHeadDrift])
+
+	subch := c.tspub.Sub(tipsetChangeTopic)
+	defer c.tspub.Unsub(subch)
+
+	// the first head arrives
+	head := genTipSetAt(t, time.Now())
+	node := c.sel.Node("a")
+	node.info.Addr = node.Addr
+	c.handleCandidate(&headCandidate{node: node, ts: head, weight: types.NewInt(100)})
+
+	changes := (<-subch).([]*api.HeadChange)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, store.HCApply, changes[0].Type)
+	assert.Equal(t, head, changes[0].Val)
+
+	ts, err := c.ChainHead(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, head, ts)
+	assert.Equal(t, StatusOK, h.Status(ctx, h.ReadyCheckers()).Status)
+}
+
+func TestNodeLatency(t *testing.T) {
+	node := &Node{Addr: "a"}
+	node.observeLatency(80 * time.Millisecond)
+	assert.Equal(t, 80*time.Millisecond, node.Latency())
+
+	node.observeLatency(160 * time.Millisecond)
+	assert.Equal(t, 90*time.Millisecond, node.Latency())
+}
@@ -0,0 +1,223 @@
+package co
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	actorstypes "github.com/filecoin-project/go-state-types/actors"
+	gstbuiltin "github.com/filecoin-project/go-state-types/builtin"
+	"github.com/filecoin-project/go-state-types/network"
+	"github.com/ipfs/go-cid"
+	cbg "github.com/whyrusleeping/cbor-gen"
+
+	"github.com/filecoin-project/lotus/chain/actors"
+	"github.com/filecoin-project/lotus/chain/actors/builtin"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+const actorCacheSize = 4096
+
+// errLegacyActor is returned for the actors older than v8, of which the params are handled by the upstream
+var errLegacyActor = errors.New("legacy actor")
+
+type actorCodeKey struct {
+	addr address.Address
+	tsk  types.TipSetKey
+}
+
+type actorMeta struct {
+	name    string
+	version actorstypes.Version
+}
+
+// StateDecodeParams impls api.FullNode.StateDecodeParams
+// The code of the actor is fetched from the upstream, the params are decoded locally,
+// except for the actors older than v8, which are decoded by the upstream.
+func (c *Coordinator) StateDecodeParams(ctx context.Context, toAddr address.Address, method abi.MethodNum, params []byte, tsk types.TipSetKey) (interface{}, error) {
+	code, err := c.actorCode(ctx, toAddr, tsk)
+	if err != nil {
+		return nil, fmt.Errorf("getting actor: %w", err)
+	}
+
+	paramType, err := c.paramType(ctx, tsk, code, method)
+	if errors.Is(err, errLegacyActor) {
+		var decoded interface{}
+		err := c.callWithFailover(ctx, tsk, "StateDecodeParams", func(ctx context.Context, node *Node) error {
+			var err error
+			decoded, err = node.FullNode().StateDecodeParams(ctx, toAddr, method, params, tsk)
+			return err
+		})
+		return decoded, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting params type: %w", err)
+	}
+
+	param := reflect.New(paramType.Elem()).Interface()
+	um, ok := param.(cbg.CBORUnmarshaler)
+	if !ok {
+		return nil, fmt.Errorf("params type %s is not cbor unmarshaler", paramType)
+	}
+
+	if err := um.UnmarshalCBOR(bytes.NewReader(params)); err != nil {
+		return nil, err
+	}
+
+	return param, nil
+}
+
+// StateEncodeParams impls api.FullNode.StateEncodeParams
+// The params of the actors older than v8 are encoded by the upstream.
+func (c *Coordinator) StateEncodeParams(ctx context.Context, toActCode cid.Cid, method abi.MethodNum, params json.RawMessage) ([]byte, error) {
+	paramType, err := c.paramType(ctx, types.EmptyTSK, toActCode, method)
+	if errors.Is(err, errLegacyActor) {
+		var encoded []byte
+		err := c.callWithFailover(ctx, types.EmptyTSK, "StateEncodeParams", func(ctx context.Context, node *Node) error {
+			var err error
+			encoded, err = node.FullNode().StateEncodeParams(ctx, toActCode, method, params)
+			return err
+		})
+		return encoded, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting params type: %w", err)
+	}
+
+	param := reflect.New(paramType.Elem()).Interface()
+	if err := json.Unmarshal(params, param); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	m, ok := param.(cbg.CBORMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("params type %s is not cbor marshaler", paramType)
+	}
+
+	var buf bytes.Buffer
+	if err := m.MarshalCBOR(&buf); err != nil {
+		return nil, fmt.Errorf("cbor marshal: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// WalletValidateAddress impls api.FullNode.WalletValidateAddress
+func (c *Coordinator) WalletValidateAddress(_ context.Context, str string) (address.Address, error) {
+	return address.NewFromString(str)
+}
+
+// actorCode returns the code of the actor at the given tipset, the coordinator head is used if tsk is empty.
+func (c *Coordinator) actorCode(ctx context.Context, addr address.Address, tsk types.TipSetKey) (cid.Cid, error) {
+	if tsk.IsEmpty() {
+		c.headMu.RLock()
+		head := c.head
+		c.headMu.RUnlock()
+
+		if head == nil {
+			return cid.Undef, ErrNoHead
+		}
+		tsk = head.Key()
+	}
+
+	key := actorCodeKey{addr: addr, tsk: tsk}
+	if code, ok := c.actorCodes.Get(key); ok {
+		return code.(cid.Cid), nil
+	}
+
+	var act *types.Actor
+	err := c.callWithFailover(ctx, tsk, "StateGetActor", func(ctx context.Context, node *Node) error {
+		var err error
+		act, err = node.FullNode().StateGetActor(ctx, addr, tsk)
+		return err
+	})
+	if err != nil {
+		return cid.Undef, err
+	}
+
+	c.actorCodes.Add(key, act.Code)
+	return act.Code, nil
+}
+
+// actorMeta returns the name and version of the builtin actor with the given code.
+// The code CIDs of the bundles embedded in lotus are used first, then the ones reported by the upstream.
+func (c *Coordinator) actorMeta(ctx context.Context, tsk types.TipSetKey, code cid.Cid) (actorMeta, error) {
+	if name, av, ok := actors.GetActorMetaByCode(code); ok {
+		return actorMeta{name: name, version: av}, nil
+	}
+
+	if meta, ok := c.actorMetas.Get(code); ok {
+		return meta.(actorMeta), nil
+	}
+
+	var nv network.Version
+	var codes map[string]cid.Cid
+	err := c.callWithFailover(ctx, tsk, "StateActorCodeCIDs", func(ctx context.Context, node *Node) error {
+		var err error
+		nv, err = node.FullNode().StateNetworkVersion(ctx, tsk)
+		if err != nil {
+			return err
+		}
+
+		codes, err = node.FullNode().StateActorCodeCIDs(ctx, nv)
+		return err
+	})
+	if err != nil {
+		return actorMeta{}, err
+	}
+
+	av, err := actorstypes.VersionForNetwork(nv)
+	if err != nil {
+		return actorMeta{}, err
+	}
+
+	for name, actCode := range codes {
+		if actCode == code {
+			meta := actorMeta{name: name, version: av}
+			c.actorMetas.Add(code, meta)
+			return meta, nil
+		}
+	}
+
+	return actorMeta{}, fmt.Errorf("unknown actor code %s", code)
+}
+
+func (c *Coordinator) paramType(ctx context.Context, tsk types.TipSetKey, code cid.Cid, method abi.MethodNum) (reflect.Type, error) {
+	if method == gstbuiltin.MethodSend {
+		return reflect.TypeOf(new(abi.EmptyValue)), nil
+	}
+
+	meta, err := c.actorMeta(ctx, tsk, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if meta.version < actorstypes.Version8 {
+		return nil, fmt.Errorf("%w %s of version %d", errLegacyActor, meta.name, meta.version)
+	}
+
+	codes, err := actors.GetActorCodeIDs(meta.version)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range builtin.MakeRegistry(meta.version) {
+		if entry.Code() != codes[meta.name] {
+			continue
+		}
+
+		mm, ok := entry.Exports()[method]
+		if !ok || mm.Method == nil {
+			return nil, fmt.Errorf("unknown method %d for actor %s", method, meta.name)
+		}
+
+		return reflect.TypeOf(mm.Method).In(0), nil
+	}
+
+	return nil, fmt.Errorf("unknown actor %s of version %d", meta.name, meta.version)
+}
@@ -0,0 +1,129 @@
+package co
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	actorstypes "github.com/filecoin-project/go-state-types/actors"
+	"github.com/filecoin-project/go-state-types/builtin"
+	multisig16 "github.com/filecoin-project/go-state-types/builtin/v16/multisig"
+	"github.com/filecoin-project/go-state-types/manifest"
+	"github.com/filecoin-project/go-state-types/network"
+	"github.com/filecoin-project/lotus/api/v1api"
+	"github.com/filecoin-project/lotus/chain/actors"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateParams(t *testing.T) {
+	head := genTipSet(t, 100)
+
+	msigCode, ok := actors.GetActorCodeID(actorstypes.Version16, manifest.MultisigKey)
+	assert.True(t, ok)
+
+	// code of a bundle unknown to lotus, resolved by the upstream
+	unknownCode := head.Blocks()[0].Messages
+
+	msig, err := address.NewIDAddress(1000)
+	assert.NoError(t, err)
+	to, err := address.NewIDAddress(1001)
+	assert.NoError(t, err)
+
+	getActor := 0
+	full := &mockFullNode{
+		getActor: func(_ context.Context, addr address.Address, tsk types.TipSetKey) (*types.Actor, error) {
+			getActor++
+			// the coordinator head is used for the empty tsk
+			assert.Equal(t, head.Key(), tsk)
+			return &types.Actor{Code: msigCode}, nil
+		},
+		actorCodes: map[string]cid.Cid{manifest.MultisigKey: unknownCode},
+	}
+	c := setupCoordinator(t, head, map[string]v1api.FullNode{"a": full})
+	ctx := context.Background()
+
+	params := &multisig16.ProposeParams{To: to, Value: abi.NewTokenAmount(10), Method: builtin.MethodSend}
+	raw, err := json.Marshal(params)
+	assert.NoError(t, err)
+
+	var expected bytes.Buffer
+	assert.NoError(t, params.MarshalCBOR(&expected))
+
+	for _, code := range []cid.Cid{msigCode, unknownCode} {
+		encoded, err := c.StateEncodeParams(ctx, code, builtin.MethodsMultisig.Propose, raw)
+		assert.NoError(t, err)
+		assert.Equal(t, expected.Bytes(), encoded)
+	}
+
+	for i := 0; i < 2; i++ {
+		decoded, err := c.StateDecodeParams(ctx, msig, builtin.MethodsMultisig.Propose, expected.Bytes(), types.EmptyTSK)
+		assert.NoError(t, err)
+		assert.Equal(t, params, decoded)
+	}
+	// actor code is cached
+	assert.Equal(t, 1, getActor)
+
+	_, err = c.StateEncodeParams(ctx, msigCode, 1000, raw)
+	assert.Error(t, err)
+
+	_, err = c.StateEncodeParams(ctx, cid.Undef, builtin.MethodsMultisig.Propose, raw)
+	assert.Error(t, err)
+}
+
+func TestStateParamsOfLegacyActor(t *testing.T) {
+	head := genTipSet(t, 100)
+
+	// code of an actor older than v8, resolved by the upstream at a historical network version
+	legacyCode := head.Blocks()[0].Messages
+	msig, err := address.NewIDAddress(1000)
+	assert.NoError(t, err)
+	tsk := genTipSet(t, 10).Key()
+
+	decoded := map[string]interface{}{"To": "f01001"}
+	encoded := []byte{0x80}
+	full := &mockFullNode{
+		getActor: func(_ context.Context, _ address.Address, _ types.TipSetKey) (*types.Actor, error) {
+			return &types.Actor{Code: legacyCode}, nil
+		},
+		actorCodes: map[string]cid.Cid{manifest.MultisigKey: legacyCode},
+		netVersion: network.Version15,
+		decodeParams: func(_ context.Context, toAddr address.Address, method abi.MethodNum, params []byte, at types.TipSetKey) (interface{}, error) {
+			assert.Equal(t, msig, toAddr)
+			assert.Equal(t, builtin.MethodsMultisig.Propose, method)
+			assert.Equal(t, encoded, params)
+			assert.Equal(t, tsk, at)
+			return decoded, nil
+		},
+		encodeParams: func(_ context.Context, code cid.Cid, _ abi.MethodNum, _ json.RawMessage) ([]byte, error) {
+			assert.Equal(t, legacyCode, code)
+			return encoded, nil
+		},
+	}
+	c := setupCoordinator(t, head, map[string]v1api.FullNode{"a": full})
+	ctx := context.Background()
+
+	// the params are passed through to the upstream instead of being rejected
+	res, err := c.StateDecodeParams(ctx, msig, builtin.MethodsMultisig.Propose, encoded, tsk)
+	assert.NoError(t, err)
+	assert.Equal(t, decoded, res)
+
+	raw, err := c.StateEncodeParams(ctx, legacyCode, builtin.MethodsMultisig.Propose, json.RawMessage(`{"To":"f01001"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, encoded, raw)
+}
+
+func TestWalletValidateAddress(t *testing.T) {
+	c := &Coordinator{}
+
+	addr, err := c.WalletValidateAddress(context.Background(), "f01000")
+	assert.NoError(t, err)
+	assert.Equal(t, "f01000", addr.String())
+
+	_, err = c.WalletValidateAddress(context.Background(), "f0abc")
+	assert.Error(t, err)
+}
@@ -0,0 +1,111 @@
+package co
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ipfs-force-community/sophon-auth/core"
+)
+
+// DefaultPool is the shared pool, nodes without labels belong to it, and users without assignment use it
+const DefaultPool = "default"
+
+// PoolOption is for node pool configuration, Users maps sophon-auth users to the pools.
+// The calls of the users fail if none of the nodes in their pool is selectable,
+// unless the pool is in Fallback, whose users use the default pool then.
+type PoolOption struct {
+	Users    map[string]string
+	Fallback []string
+}
+
+// DefaultPoolOption returns default options, all the users use the default pool
+func DefaultPoolOption() PoolOption {
+	return PoolOption{}
+}
+
+// NewPools constructs a Pools instance
+func NewPools(opt PoolOption) *Pools {
+	users := make(map[string]string, len(opt.Users))
+	for user, pool := range opt.Users {
+		if pool != "" && pool != DefaultPool {
+			users[user] = pool
+		}
+	}
+
+	fallback := make(map[string]struct{}, len(opt.Fallback))
+	for _, pool := range opt.Fallback {
+		fallback[pool] = struct{}{}
+	}
+
+	return &Pools{users: users, fallback: fallback}
+}
+
+// Pools keeps the pools assigned to the users, a pool is a label of the nodes
+type Pools struct {
+	lk    sync.RWMutex
+	users map[string]string
+	// fallback are the pools whose users use the default pool if none of the nodes in the pool is selectable
+	fallback map[string]struct{}
+}
+
+// SetUserPool assigns the user to the pool, the user goes back to the default pool if pool is empty.
+// The assignment lives in memory only, it's lost on restart unless it's added to the config as well.
+func (p *Pools) SetUserPool(user, pool string) {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+
+	if pool == "" || pool == DefaultPool {
+		delete(p.users, user)
+		return
+	}
+
+	p.users[user] = pool
+}
+
+// ListUserPools returns the users not in the default pool
+func (p *Pools) ListUserPools() map[string]string {
+	p.lk.RLock()
+	defer p.lk.RUnlock()
+
+	ret := make(map[string]string, len(p.users))
+	for user, pool := range p.users {
+		ret[user] = pool
+	}
+	return ret
+}
+
+// PoolOf returns the pool of the caller set in the context by sophon-auth
+func (p *Pools) PoolOf(ctx context.Context) string {
+	user, ok := (&core.ValueFromCtx{}).AccFromCtx(ctx)
+	if !ok {
+		return DefaultPool
+	}
+
+	p.lk.RLock()
+	defer p.lk.RUnlock()
+
+	if pool, ok := p.users[user]; ok {
+		return pool
+	}
+	return DefaultPool
+}
+
+// fallsBack returns true if the users of the pool use the default pool when none of the nodes in the pool is selectable
+func (p *Pools) fallsBack(pool string) bool {
+	_, ok := p.fallback[pool]
+	return ok
+}
+
+// inPool checks if the node has the pool label, nodes without labels are in the default pool
+func (n *Node) inPool(pool string) bool {
+	if len(n.Labels) == 0 {
+		return pool == DefaultPool
+	}
+
+	for _, label := range n.Labels {
+		if label == pool {
+			return true
+		}
+	}
+	return false
+}
@@ -3,15 +3,18 @@ package co
 import (
 	"context"
 	"fmt"
+	"math"
+	"sort"
 	"sync"
 
 	"github.com/filecoin-project/lotus/chain/types"
 	"github.com/ipfs-force-community/metrics"
+	"go.opencensus.io/trace"
 )
 
 type Priority int
 
-var nodePriority = metrics.NewInt64WithCategory("node_priority", "node priority. 0:ErrPriority, 1:DelayPriority, 2:CatchUpPriority", "")
+var nodePriority = metrics.NewInt64WithCategory("node_priority", "node priority. 0:ErrPriority, 1:DelayPriority, 2:CatchUpPriority", "", NetworkKey)
 
 const (
 	// MaxWeight is the default max weight of a node
@@ -24,6 +27,9 @@ const (
 	// it will never be selected unless it's recover manually
 	BlockWeight = 0
 )
+
+// loadTolerance is the difference of the load per weight, under which the nodes are balanced by the weights only
+const loadTolerance = 10
 const (
 	// ErrPriority means the node once respond with error and will be selected with lowest priority
 	ErrPriority = iota
@@ -33,25 +39,35 @@ const (
 	CatchUpPriority
 )
 
-// NewSelector constructs a Selector instance
-func NewSelector(nodes INodeStore) (*Selector, error) {
+// NewSelector constructs a Selector instance, nodes are not filtered by the pool of the caller if pools is nil
+func NewSelector(cctx *Ctx, nodes INodeStore, pools *Pools) (*Selector, error) {
 	sel := &Selector{}
+	sel.ctx = cctx
 	sel.weight = make(map[string]int)
 	sel.priority = make(map[string]int)
+	sel.blocked = make(map[string]blockState)
+	sel.draining = make(map[string]struct{})
 	sel.selectALG = SWRRA()
 	sel.nodeProvider = nodes
+	sel.pools = pools
 
 	return sel, nil
 }
 
 // Selector is used to select a best chain node to route the requests to
 type Selector struct {
+	// ctx is tagged with the network, the metrics are recorded with it
+	ctx *Ctx
+
 	lk        sync.RWMutex
 	weight    map[string]int
 	priority  map[string]int
+	blocked   map[string]blockState
+	draining  map[string]struct{}
 	selectALG func(map[string]int) (string, error)
 
 	nodeProvider INodeStore
+	pools        *Pools
 }
 
 func (s *Selector) AddNodes(nodes ...*Node) {
@@ -84,7 +100,7 @@ func (s *Selector) getAddrOfPriority(priority int) []string {
 func (s *Selector) setPriority(priority int, addrs ...string) {
 	s.lk.Lock()
 	defer s.lk.Unlock()
-	ctx := context.Background()
+	ctx := s.ctx.lc
 
 	if priority < ErrPriority {
 		priority = ErrPriority
@@ -99,6 +115,14 @@ func (s *Selector) setPriority(priority int, addrs ...string) {
 		nodePriority.Set(ctx, addr, int64(priority))
 		log.Debugf("change priority of %s from %d to %d", addr, current, priority)
 	}
+
+	caughtUp := 0
+	for _, p := range s.priority {
+		if p == CatchUpPriority {
+			caughtUp++
+		}
+	}
+	caughtUpNodes.Set(ctx, int64(caughtUp))
 }
 
 func (s *Selector) ListPriority() map[string]int {
@@ -111,6 +135,24 @@ func (s *Selector) ListPriority() map[string]int {
 	return ret
 }
 
+// Node returns the node of the address, nil if it's not found
+func (s *Selector) Node(addr string) *Node {
+	return s.nodeProvider.GetNode(addr)
+}
+
+// ListLabels returns the labels of the nodes
+func (s *Selector) ListLabels() map[string][]string {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+	ret := make(map[string][]string, len(s.priority))
+	for addr := range s.priority {
+		if node := s.nodeProvider.GetNode(addr); node != nil {
+			ret[addr] = append([]string{}, node.Labels...)
+		}
+	}
+	return ret
+}
+
 func (s *Selector) SetWeight(addr string, weight int) error {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -126,10 +168,76 @@ func (s *Selector) SetWeight(addr string, weight int) error {
 		return fmt.Errorf("node %s not found", addr)
 	}
 	s.weight[addr] = weight
+	if weight != BlockWeight {
+		delete(s.blocked, addr)
+	}
 	log.Debugf("change priority of %s from %d to %d", addr, current, weight)
 	return nil
 }
 
+// blockState is the reason and the weight before the node is blocked
+type blockState struct {
+	reason string
+	weight int
+}
+
+// Block sets the weight of the node to BlockWeight, the weight before is restored by Unblock
+func (s *Selector) Block(addr string, reason string) error {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	current, ok := s.weight[addr]
+	if !ok {
+		return fmt.Errorf("node %s not found", addr)
+	}
+
+	if prev, blocked := s.blocked[addr]; blocked {
+		current = prev.weight
+	}
+	s.blocked[addr] = blockState{reason: reason, weight: current}
+	s.weight[addr] = BlockWeight
+	log.Infof("block node %s: %s", addr, reason)
+	return nil
+}
+
+// Unblock restores the weight of the node before it's blocked,
+// or DefaultWeight if the node is blocked by SetWeight.
+func (s *Selector) Unblock(addr string) error {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	current, ok := s.weight[addr]
+	if !ok {
+		return fmt.Errorf("node %s not found", addr)
+	}
+	if current != BlockWeight {
+		return fmt.Errorf("node %s is not blocked", addr)
+	}
+
+	weight := DefaultWeight
+	if prev, blocked := s.blocked[addr]; blocked && prev.weight != BlockWeight {
+		weight = prev.weight
+	}
+	delete(s.blocked, addr)
+	s.weight[addr] = weight
+	log.Infof("unblock node %s, weight %d", addr, weight)
+	return nil
+}
+
+// BlockReasons returns the reasons of the blocked nodes, the reason is empty if the node is blocked by SetWeight
+func (s *Selector) BlockReasons() map[string]string {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	ret := make(map[string]string)
+	for addr, w := range s.weight {
+		if w == BlockWeight {
+			ret[addr] = s.blocked[addr].reason
+		}
+	}
+	return ret
+}
+
 func (s *Selector) Weight(addr string) int {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -147,17 +255,150 @@ func (s *Selector) ListWeight() map[string]int {
 	return newWeight
 }
 
-// Select tries to choose a node from the candidates
-func (s *Selector) Select(tsk types.TipSetKey) (*Node, error) {
+// Select tries to choose a node from the candidates in the pool of the caller
+func (s *Selector) Select(ctx context.Context, tsk types.TipSetKey) (*Node, error) {
+	ctx, span := trace.StartSpan(ctx, "co.Select")
+	defer span.End()
+
 	s.lk.RLock()
 	defer s.lk.RUnlock()
 
-	errQue := make(map[string]int)
-	delayQue := make(map[string]int)
-	catchUpQue := make(map[string]int)
+	errQue, delayQue, catchUpQue, pool := s.poolQueues(ctx, tsk)
+
+	var addr string = ""
+	priority := CatchUpPriority
+	if len(catchUpQue) > 0 {
+		addr, _ = s.selectALG(s.candidates(catchUpQue))
+	}
+	if addr == "" && len(delayQue) > 0 {
+		addr, _ = s.selectALG(s.candidates(delayQue))
+		priority = DelayPriority
+	}
+	if addr == "" && len(errQue) > 0 {
+		addr, _ = s.selectALG(s.candidates(errQue))
+		priority = ErrPriority
+	}
+
+	span.AddAttributes(trace.StringAttribute("tsk", tsk.String()))
+	if addr == "" {
+		err := ErrNoNodeAvailable
+		if caps := callCapabilities(ctx); len(caps) > 0 {
+			err = errNoCapableNode(caps)
+		}
+		if pool != "" && pool != DefaultPool {
+			err = fmt.Errorf("%w in pool %s", err, pool)
+		}
+		span.SetStatus(trace.Status{Code: trace.StatusCodeUnavailable, Message: err.Error()})
+		return nil, err
+	}
+
+	span.AddAttributes(trace.StringAttribute("node", addr), trace.Int64Attribute("priority", int64(priority)))
+	return s.nodeProvider.GetNode(addr), nil
+}
+
+// SelectN returns at most n nodes whose priority is not lower than minPriority,
+// ordered from the most preferred to the least preferred one, nodes with free slots and less load are preferred.
+// The first node of each priority is chosen by the select algorithm, so that
+// the load is still balanced between the nodes.
+// n <= 0 means no limit.
+func (s *Selector) SelectN(ctx context.Context, tsk types.TipSetKey, n int, minPriority int) []*Node {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	errQue, delayQue, catchUpQue, _ := s.poolQueues(ctx, tsk)
+	ques := []map[string]int{catchUpQue}
+	if minPriority <= DelayPriority {
+		ques = append(ques, delayQue)
+	}
+	if minPriority <= ErrPriority {
+		ques = append(ques, errQue)
+	}
+
+	ret := make([]*Node, 0, len(s.priority))
+	for _, que := range ques {
+		first, err := s.selectALG(s.candidates(que))
+		if err != nil {
+			continue
+		}
+
+		addrs := make([]string, 0, len(que))
+		for addr, w := range que {
+			if addr != first && w > BlockWeight {
+				addrs = append(addrs, addr)
+			}
+		}
+		free := make(map[string]bool, len(addrs))
+		// loads are compared in steps of loadTolerance, so that the order is still made by the weights for the light loads
+		loads := make(map[string]int64, len(addrs))
+		for _, addr := range addrs {
+			node := s.nodeProvider.GetNode(addr)
+			free[addr] = node.hasFreeSlot()
+			loads[addr] = node.Load() / int64(que[addr]) / loadTolerance
+		}
+		sort.Slice(addrs, func(i, j int) bool {
+			if free[addrs[i]] != free[addrs[j]] {
+				return free[addrs[i]]
+			}
+			if loads[addrs[i]] != loads[addrs[j]] {
+				return loads[addrs[i]] < loads[addrs[j]]
+			}
+			if que[addrs[i]] != que[addrs[j]] {
+				return que[addrs[i]] > que[addrs[j]]
+			}
+			return addrs[i] < addrs[j]
+		})
+
+		for _, addr := range append([]string{first}, addrs...) {
+			if n > 0 && len(ret) >= n {
+				return ret
+			}
+			ret = append(ret, s.nodeProvider.GetNode(addr))
+		}
+	}
+
+	return ret
+}
+
+// poolQueues groups the nodes in the pool of the caller, which is returned as well, should be called with s.lk held.
+// The default pool is used if none of the nodes in the pool is selectable and the pool falls back.
+func (s *Selector) poolQueues(ctx context.Context, tsk types.TipSetKey) (errQue, delayQue, catchUpQue map[string]int, pool string) {
+	caps := callCapabilities(ctx)
+	if s.pools == nil {
+		errQue, delayQue, catchUpQue = s.queues(tsk, "", caps)
+		return errQue, delayQue, catchUpQue, ""
+	}
+
+	pool = s.pools.PoolOf(ctx)
+	errQue, delayQue, catchUpQue = s.queues(tsk, pool, caps)
+	if pool == DefaultPool || !s.pools.fallsBack(pool) || hasSelectable(errQue, delayQue, catchUpQue) {
+		return errQue, delayQue, catchUpQue, pool
+	}
+
+	log.Warnf("no node available in pool %s, fallback to the default pool", pool)
+	errQue, delayQue, catchUpQue = s.queues(tsk, DefaultPool, caps)
+	return errQue, delayQue, catchUpQue, DefaultPool
+}
+
+// queues groups the nodes in the pool by their priority for the given tipset, should be called with s.lk held.
+// All the nodes are grouped if pool is empty, the draining nodes and the ones without caps are left out.
+func (s *Selector) queues(tsk types.TipSetKey, pool string, caps []Capability) (errQue, delayQue, catchUpQue map[string]int) {
+	errQue = make(map[string]int)
+	delayQue = make(map[string]int)
+	catchUpQue = make(map[string]int)
 
 	for addr, p := range s.priority {
+		if _, ok := s.draining[addr]; ok {
+			continue
+		}
+
 		node := s.nodeProvider.GetNode(addr)
+		if node != nil && (node.Refused() || !node.Capabilities().Has(caps...)) {
+			continue
+		}
+		if pool != "" && !node.inPool(pool) {
+			continue
+		}
+
 		if !tsk.IsEmpty() && p != ErrPriority {
 			if node.hasTipset(tsk) {
 				log.Debugf("node %s has tipset %s, change to catchup node", addr, tsk.Cids())
@@ -178,22 +419,65 @@ func (s *Selector) Select(tsk types.TipSetKey) (*Node, error) {
 		}
 	}
 
-	var addr string = ""
-	if len(catchUpQue) > 0 {
-		addr, _ = s.selectALG(catchUpQue)
+	return errQue, delayQue, catchUpQue
+}
+
+// candidates narrows the queue to the nodes preferred by the load, the select algorithm chooses one of them
+func (s *Selector) candidates(que map[string]int) map[string]int {
+	return s.preferLessLoaded(s.preferFreeSlots(que))
+}
+
+// preferLessLoaded returns the nodes whose load per weight is within loadTolerance of the least loaded one,
+// so that heavy calls are spread over the nodes instead of piling onto the one chosen by the weights.
+func (s *Selector) preferLessLoaded(que map[string]int) map[string]int {
+	loads := make(map[string]float64, len(que))
+	minLoad := math.MaxFloat64
+	for addr, w := range que {
+		if w <= BlockWeight {
+			continue
+		}
+		loads[addr] = float64(s.nodeProvider.GetNode(addr).Load()) / float64(w)
+		minLoad = math.Min(minLoad, loads[addr])
 	}
-	if addr == "" && len(delayQue) > 0 {
-		addr, _ = s.selectALG(delayQue)
+
+	less := make(map[string]int, len(loads))
+	for addr, load := range loads {
+		if load <= minLoad+loadTolerance {
+			less[addr] = que[addr]
+		}
 	}
-	if addr == "" && len(errQue) > 0 {
-		addr, _ = s.selectALG(errQue)
+
+	if len(less) == 0 {
+		return que
 	}
+	return less
+}
 
-	if addr == "" {
-		return nil, ErrNoNodeAvailable
+// preferFreeSlots returns the nodes with free slots in the queue, or the whole queue if all of them are saturated,
+// in which case the calls wait in the queue of the selected node.
+func (s *Selector) preferFreeSlots(que map[string]int) map[string]int {
+	free := make(map[string]int, len(que))
+	for addr, w := range que {
+		if w > BlockWeight && s.nodeProvider.GetNode(addr).hasFreeSlot() {
+			free[addr] = w
+		}
 	}
 
-	return s.nodeProvider.GetNode(addr), nil
+	if len(free) == 0 {
+		return que
+	}
+	return free
+}
+
+func hasSelectable(ques ...map[string]int) bool {
+	for _, que := range ques {
+		for _, w := range que {
+			if w > BlockWeight {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // Smooth Weight Round Robin Algorithm
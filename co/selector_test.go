@@ -1,12 +1,14 @@
 package co
 
 import (
+	"context"
 	"testing"
 
 	"github.com/filecoin-project/go-address"
 	"github.com/filecoin-project/go-state-types/crypto"
 	"github.com/filecoin-project/lotus/api"
 	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs-force-community/sophon-auth/core"
 	"github.com/ipfs/go-cid"
 
 	"github.com/golang/mock/gomock"
@@ -20,7 +22,7 @@ func Test_Selector_Init(t *testing.T) {
 	nodeStore := NewMockINodeStore(ctrl)
 
 	// init selector
-	sel, _ := NewSelector(nodeStore)
+	sel, _ := NewSelector(&Ctx{lc: context.Background()}, nodeStore, nil)
 	nodeStore.EXPECT().AddNodes(gomock.Any())
 	sel.AddNodes(
 		&Node{Addr: "a"},
@@ -76,7 +78,7 @@ func TestSelect(t *testing.T) {
 	nodeStore := NewMockINodeStore(ctrl)
 
 	// init selector
-	sel, _ := NewSelector(nodeStore)
+	sel, _ := NewSelector(&Ctx{lc: context.Background()}, nodeStore, nil)
 	nodeStore.EXPECT().AddNodes(gomock.Any())
 
 	var nodes []*Node
@@ -118,11 +120,11 @@ func TestSelect(t *testing.T) {
 		addr := addrs[i%2]
 		sel.setPriority(CatchUpPriority, addr)
 
-		node, err := sel.Select(types.EmptyTSK)
+		node, err := sel.Select(context.Background(), types.EmptyTSK)
 		assert.NoError(t, err)
 		assert.Equal(t, addr, node.Addr)
 
-		node, err = sel.Select(ts.Key())
+		node, err = sel.Select(context.Background(), ts.Key())
 		assert.NoError(t, err)
 		assert.Equal(t, "c", node.Addr)
 
@@ -137,7 +139,7 @@ func Test_Selector_UpdateNodes(t *testing.T) {
 	nodeStore := NewMockINodeStore(ctrl)
 
 	// init selector
-	sel, _ := NewSelector(nodeStore)
+	sel, _ := NewSelector(&Ctx{lc: context.Background()}, nodeStore, nil)
 	nodeStore.EXPECT().AddNodes(gomock.Any())
 	nodes := []*Node{{Addr: "a"},
 		{Addr: "b"},
@@ -162,7 +164,7 @@ func Test_Selector_UpdateNodes(t *testing.T) {
 			}
 			return nil
 		})
-		node, err := sel.Select(types.EmptyTSK)
+		node, err := sel.Select(context.Background(), types.EmptyTSK)
 		records = append(records, node.Addr)
 		assert.NoError(t, err)
 	}
@@ -178,7 +180,7 @@ func Test_Selector_SetWeight(t *testing.T) {
 	nodeStore := NewMockINodeStore(ctrl)
 
 	// init selector
-	sel, _ := NewSelector(nodeStore)
+	sel, _ := NewSelector(&Ctx{lc: context.Background()}, nodeStore, nil)
 
 	nodeStore.EXPECT().AddNodes(gomock.Any())
 	nodes := []*Node{{Addr: "a"},
@@ -202,7 +204,7 @@ func Test_Selector_SetWeight(t *testing.T) {
 			}
 			return nil
 		})
-		node, err := sel.Select(types.EmptyTSK)
+		node, err := sel.Select(context.Background(), types.EmptyTSK)
 		records = append(records, node.Addr)
 		assert.NoError(t, err)
 	}
@@ -220,7 +222,7 @@ func Test_Selector_SetWeight_BlockWeight(t *testing.T) {
 	nodeStore := NewMockINodeStore(ctrl)
 
 	// init selector
-	sel, _ := NewSelector(nodeStore)
+	sel, _ := NewSelector(&Ctx{lc: context.Background()}, nodeStore, nil)
 
 	nodeStore.EXPECT().AddNodes(gomock.Any())
 	nodes := []*Node{{Addr: "a"},
@@ -246,7 +248,7 @@ func Test_Selector_SetWeight_BlockWeight(t *testing.T) {
 			}
 			return nil
 		})
-		node, err := sel.Select(types.EmptyTSK)
+		node, err := sel.Select(context.Background(), types.EmptyTSK)
 		records = append(records, node.Addr)
 		assert.NoError(t, err)
 	}
@@ -258,7 +260,7 @@ func Test_Selector_SetWeight_BlockWeight(t *testing.T) {
 	sel.SetWeight("a", BlockWeight) // nolint:errcheck
 	sel.SetWeight("c", BlockWeight) // nolint:errcheck
 	sel.SetWeight("b", BlockWeight) // nolint:errcheck
-	_, err := sel.Select(types.EmptyTSK)
+	_, err := sel.Select(context.Background(), types.EmptyTSK)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNoNodeAvailable, err)
 }
@@ -269,7 +271,7 @@ func Test_Selector_SetPriority(t *testing.T) {
 
 	nodeStore := NewMockINodeStore(ctrl)
 
-	sel, _ := NewSelector(nodeStore)
+	sel, _ := NewSelector(&Ctx{lc: context.Background()}, nodeStore, nil)
 	nodeStore.EXPECT().AddNodes(gomock.Any())
 	nodes := []*Node{{Addr: "a"},
 		{Addr: "b"},
@@ -288,7 +290,7 @@ func Test_Selector_SetPriority(t *testing.T) {
 	sel.setPriority(CatchUpPriority, "a")
 	records := make([]string, 0)
 	for i := 0; i < 4; i++ {
-		node, err := sel.Select(types.EmptyTSK)
+		node, err := sel.Select(context.Background(), types.EmptyTSK)
 		records = append(records, node.Addr)
 		assert.NoError(t, err)
 	}
@@ -301,7 +303,7 @@ func Test_Selector_SetPriority(t *testing.T) {
 	sel.setPriority(DelayPriority, "c")
 	records = make([]string, 0)
 	for i := 0; i < 4; i++ {
-		node, err := sel.Select(types.EmptyTSK)
+		node, err := sel.Select(context.Background(), types.EmptyTSK)
 		records = append(records, node.Addr)
 		assert.NoError(t, err)
 	}
@@ -315,7 +317,7 @@ func Test_Selector_SetPriority(t *testing.T) {
 	sel.setPriority(CatchUpPriority, "c")
 	records = make([]string, 0)
 	for i := 0; i < 3; i++ {
-		node, err := sel.Select(types.EmptyTSK)
+		node, err := sel.Select(context.Background(), types.EmptyTSK)
 		records = append(records, node.Addr)
 		assert.NoError(t, err)
 	}
@@ -329,7 +331,7 @@ func Test_Selector_SetPriority(t *testing.T) {
 	sel.setPriority(ErrPriority, "c")
 	records = make([]string, 0)
 	for i := 0; i < 3; i++ {
-		node, err := sel.Select(types.EmptyTSK)
+		node, err := sel.Select(context.Background(), types.EmptyTSK)
 		records = append(records, node.Addr)
 		assert.NoError(t, err)
 	}
@@ -343,7 +345,7 @@ func Test_Selector_SetPriority(t *testing.T) {
 	sel.setPriority(ErrPriority, "c")
 	records = make([]string, 0)
 	for i := 0; i < 3; i++ {
-		node, err := sel.Select(types.EmptyTSK)
+		node, err := sel.Select(context.Background(), types.EmptyTSK)
 		records = append(records, node.Addr)
 		assert.NoError(t, err)
 	}
@@ -357,7 +359,7 @@ func Test_Selector_SetPriority_SetWeight(t *testing.T) {
 
 	nodeStore := NewMockINodeStore(ctrl)
 
-	sel, _ := NewSelector(nodeStore)
+	sel, _ := NewSelector(&Ctx{lc: context.Background()}, nodeStore, nil)
 	nodeStore.EXPECT().AddNodes(gomock.Any())
 	nodes := []*Node{{Addr: "a"},
 		{Addr: "b"},
@@ -378,7 +380,7 @@ func Test_Selector_SetPriority_SetWeight(t *testing.T) {
 	sel.setPriority(ErrPriority, "c")
 	records := make([]string, 0)
 	for i := 0; i < 4; i++ {
-		node, err := sel.Select(types.EmptyTSK)
+		node, err := sel.Select(context.Background(), types.EmptyTSK)
 		records = append(records, node.Addr)
 		assert.NoError(t, err)
 	}
@@ -389,7 +391,7 @@ func Test_Selector_SetPriority_SetWeight(t *testing.T) {
 	sel.SetWeight("a", BlockWeight) // nolint:errcheck
 	records = make([]string, 0)
 	for i := 0; i < 4; i++ {
-		node, err := sel.Select(types.EmptyTSK)
+		node, err := sel.Select(context.Background(), types.EmptyTSK)
 		records = append(records, node.Addr)
 		assert.NoError(t, err)
 	}
@@ -401,7 +403,7 @@ func Test_Selector_SetPriority_SetWeight(t *testing.T) {
 	sel.SetWeight("b", BlockWeight) // nolint:errcheck
 	records = make([]string, 0)
 	for i := 0; i < 4; i++ {
-		node, err := sel.Select(types.EmptyTSK)
+		node, err := sel.Select(context.Background(), types.EmptyTSK)
 		records = append(records, node.Addr)
 		assert.NoError(t, err)
 	}
@@ -412,10 +414,202 @@ func Test_Selector_SetPriority_SetWeight(t *testing.T) {
 	sel.SetWeight("a", BlockWeight) // nolint:errcheck
 	sel.SetWeight("b", BlockWeight) // nolint:errcheck
 	sel.SetWeight("c", BlockWeight) // nolint:errcheck
-	_, err := sel.Select(types.EmptyTSK)
+	_, err := sel.Select(context.Background(), types.EmptyTSK)
 	assert.Equal(t, ErrNoNodeAvailable, err)
 }
 
+func Test_Selector_SelectN(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nodeStore := NewMockINodeStore(ctrl)
+
+	sel, _ := NewSelector(&Ctx{lc: context.Background()}, nodeStore, nil)
+	nodeStore.EXPECT().AddNodes(gomock.Any())
+	nodes := []*Node{{Addr: "a"},
+		{Addr: "b"},
+		{Addr: "c"},
+		{Addr: "d"}}
+	sel.AddNodes(nodes...)
+	nodeStore.EXPECT().GetNode(gomock.Any()).AnyTimes().DoAndReturn(func(arg0 string) *Node {
+		for _, node := range nodes {
+			if node.Addr == arg0 {
+				return node
+			}
+		}
+		return nil
+	})
+
+	// a 2 b 2 c 1 d 0
+	sel.setPriority(CatchUpPriority, "a", "b")
+	sel.setPriority(ErrPriority, "d")
+
+	addrsOf := func(nodes []*Node) []string {
+		addrs := make([]string, 0, len(nodes))
+		for _, node := range nodes {
+			addrs = append(addrs, node.Addr)
+		}
+		return addrs
+	}
+
+	assert.ElementsMatch(t, []string{"a", "b"}, addrsOf(sel.SelectN(context.Background(), types.EmptyTSK, 0, CatchUpPriority)))
+	assert.Len(t, sel.SelectN(context.Background(), types.EmptyTSK, 1, CatchUpPriority), 1)
+
+	addrs := addrsOf(sel.SelectN(context.Background(), types.EmptyTSK, 3, DelayPriority))
+	assert.Len(t, addrs, 3)
+	assert.ElementsMatch(t, []string{"a", "b"}, addrs[:2])
+	assert.Equal(t, "c", addrs[2])
+
+	addrs = addrsOf(sel.SelectN(context.Background(), types.EmptyTSK, 0, ErrPriority))
+	assert.Equal(t, []string{"c", "d"}, addrs[2:])
+
+	// blocked nodes are never selected
+	sel.SetWeight("a", BlockWeight) // nolint:errcheck
+	assert.Equal(t, []string{"b"}, addrsOf(sel.SelectN(context.Background(), types.EmptyTSK, 0, CatchUpPriority)))
+}
+
+func Test_Selector_FreeSlots(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nodeStore := NewMockINodeStore(ctrl)
+
+	sel, _ := NewSelector(&Ctx{lc: context.Background()}, nodeStore, nil)
+	nodeStore.EXPECT().AddNodes(gomock.Any())
+	nodes := []*Node{{Addr: "a", slots: make(chan struct{}, 1)},
+		{Addr: "b", slots: make(chan struct{}, 1)}}
+	sel.AddNodes(nodes...)
+	nodeStore.EXPECT().GetNode(gomock.Any()).AnyTimes().DoAndReturn(func(arg0 string) *Node {
+		for _, node := range nodes {
+			if node.Addr == arg0 {
+				return node
+			}
+		}
+		return nil
+	})
+	sel.setPriority(CatchUpPriority, "a", "b")
+	sel.SetWeight("a", MaxValidWeight) // nolint:errcheck
+
+	// a is saturated
+	nodes[0].slots <- struct{}{}
+	for i := 0; i < 4; i++ {
+		node, err := sel.Select(context.Background(), types.EmptyTSK)
+		assert.NoError(t, err)
+		assert.Equal(t, "b", node.Addr)
+		assert.Equal(t, "b", sel.SelectN(context.Background(), types.EmptyTSK, 0, CatchUpPriority)[0].Addr)
+	}
+
+	// all the nodes are saturated, the calls will be queued
+	nodes[1].slots <- struct{}{}
+	_, err := sel.Select(context.Background(), types.EmptyTSK)
+	assert.NoError(t, err)
+}
+
+func Test_Selector_Load(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nodeStore := NewMockINodeStore(ctrl)
+
+	sel, _ := NewSelector(&Ctx{lc: context.Background()}, nodeStore, nil)
+	nodeStore.EXPECT().AddNodes(gomock.Any())
+	nodes := []*Node{{Addr: "a"}, {Addr: "b"}}
+	sel.AddNodes(nodes...)
+	nodeStore.EXPECT().GetNode(gomock.Any()).AnyTimes().DoAndReturn(func(arg0 string) *Node {
+		for _, node := range nodes {
+			if node.Addr == arg0 {
+				return node
+			}
+		}
+		return nil
+	})
+	sel.setPriority(CatchUpPriority, "a", "b")
+	sel.SetWeight("a", MaxValidWeight) // nolint:errcheck
+
+	// light loads are balanced by the weights
+	nodes[0].load.Store(50)
+	nodes[1].load.Store(1)
+	counts := map[string]int{}
+	for i := 0; i < 11; i++ {
+		node, err := sel.Select(context.Background(), types.EmptyTSK)
+		assert.NoError(t, err)
+		counts[node.Addr]++
+	}
+	assert.Equal(t, map[string]int{"a": 10, "b": 1}, counts)
+
+	// a is busy with heavy calls
+	nodes[0].load.Store(1000)
+	for i := 0; i < 4; i++ {
+		node, err := sel.Select(context.Background(), types.EmptyTSK)
+		assert.NoError(t, err)
+		assert.Equal(t, "b", node.Addr)
+		assert.Equal(t, "b", sel.SelectN(context.Background(), types.EmptyTSK, 0, CatchUpPriority)[0].Addr)
+	}
+}
+
+func Test_Selector_Pool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nodeStore := NewMockINodeStore(ctrl)
+
+	pools := NewPools(PoolOption{Users: map[string]string{"alice": "dedicated"}})
+	sel, _ := NewSelector(&Ctx{lc: context.Background()}, nodeStore, pools)
+	nodeStore.EXPECT().AddNodes(gomock.Any())
+	nodes := []*Node{{Addr: "a"},
+		{Addr: "b", Labels: []string{DefaultPool, "dedicated"}},
+		{Addr: "c", Labels: []string{"dedicated"}},
+		{Addr: "d", Labels: []string{"vip"}}}
+	sel.AddNodes(nodes...)
+	nodeStore.EXPECT().GetNode(gomock.Any()).AnyTimes().DoAndReturn(func(arg0 string) *Node {
+		for _, node := range nodes {
+			if node.Addr == arg0 {
+				return node
+			}
+		}
+		return nil
+	})
+	sel.setPriority(CatchUpPriority, "a", "b", "c", "d")
+
+	selected := func(ctx context.Context) []string {
+		addrs := make([]string, 0)
+		for _, node := range sel.SelectN(ctx, types.EmptyTSK, 0, ErrPriority) {
+			addrs = append(addrs, node.Addr)
+		}
+		return addrs
+	}
+
+	alice := core.CtxWithName(context.Background(), "alice")
+	bob := core.CtxWithName(context.Background(), "bob")
+
+	assert.ElementsMatch(t, []string{"a", "b"}, selected(context.Background()))
+	assert.ElementsMatch(t, []string{"a", "b"}, selected(bob))
+	assert.ElementsMatch(t, []string{"b", "c"}, selected(alice))
+
+	pools.SetUserPool("bob", "vip")
+	assert.Equal(t, map[string]string{"alice": "dedicated", "bob": "vip"}, pools.ListUserPools())
+	for i := 0; i < 4; i++ {
+		node, err := sel.Select(bob, types.EmptyTSK)
+		assert.NoError(t, err)
+		assert.Equal(t, "d", node.Addr)
+	}
+
+	// the calls fail if none of the nodes in the pool is selectable
+	sel.SetWeight("d", BlockWeight) // nolint:errcheck
+	assert.Empty(t, selected(bob))
+	_, err := sel.Select(bob, types.EmptyTSK)
+	assert.ErrorIs(t, err, ErrNoNodeAvailable)
+	assert.Contains(t, err.Error(), "pool vip")
+
+	// unless the pool falls back to the default pool
+	pools.fallback["vip"] = struct{}{}
+	assert.ElementsMatch(t, []string{"a", "b"}, selected(bob))
+
+	pools.SetUserPool("alice", "")
+	assert.ElementsMatch(t, []string{"a", "b"}, selected(alice))
+	assert.Equal(t, map[string][]string{"a": {}, "b": {DefaultPool, "dedicated"}, "c": {"dedicated"}, "d": {"vip"}}, sel.ListLabels())
+}
+
 func Test_SWRRA(t *testing.T) {
 	cases := []map[string]int{
 		{"a": 1, "b": 2},
@@ -482,3 +676,34 @@ func sumWeight(weight map[string]int) int {
 	}
 	return res
 }
+
+func Test_Selector_Block(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nodeStore := NewMockINodeStore(ctrl)
+
+	sel, _ := NewSelector(&Ctx{lc: context.Background()}, nodeStore, nil)
+	nodeStore.EXPECT().AddNodes(gomock.Any())
+	sel.AddNodes(&Node{Addr: "a"}, &Node{Addr: "b"})
+	assert.NoError(t, sel.SetWeight("a", 5))
+
+	assert.NoError(t, sel.Block("a", "maintenance"))
+	assert.Equal(t, BlockWeight, sel.Weight("a"))
+	assert.Equal(t, map[string]string{"a": "maintenance"}, sel.BlockReasons())
+
+	// blocked again, the weight before the first block is kept
+	assert.NoError(t, sel.Block("a", "still in maintenance"))
+	assert.NoError(t, sel.Unblock("a"))
+	assert.Equal(t, 5, sel.Weight("a"))
+	assert.Empty(t, sel.BlockReasons())
+	assert.Error(t, sel.Unblock("a"))
+
+	// blocked by SetWeight
+	assert.NoError(t, sel.SetWeight("b", BlockWeight))
+	assert.Equal(t, map[string]string{"b": ""}, sel.BlockReasons())
+	assert.NoError(t, sel.Unblock("b"))
+	assert.Equal(t, DefaultWeight, sel.Weight("b"))
+
+	assert.Error(t, sel.Block("c", ""))
+}
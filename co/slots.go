@@ -0,0 +1,185 @@
+package co
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/filecoin-project/lotus/api/v1api"
+	v1 "github.com/filecoin-project/venus/venus-shared/api/chain/v1"
+	"github.com/ipfs-force-community/metrics"
+	"go.opencensus.io/tag"
+	"go.opencensus.io/trace"
+
+	"github.com/ipfs-force-community/sophon-co/apiwrap"
+)
+
+// ErrNodeBusy is returned when the calls can't get a free slot of the node in time
+var ErrNodeBusy = fmt.Errorf("node busy")
+
+var nodeKey = tag.MustNewKey("node")
+
+var (
+	nodeQueueDepth = metrics.NewInt64("node_queue_depth", "number of calls waiting for a free slot of the node", "", nodeKey)
+	nodeQueueWait  = metrics.NewTimerMs("node_queue_wait", "time the calls waited for a free slot of the node", nodeKey)
+)
+
+// hasFreeSlot returns true if the node is not saturated by the calls in flight
+func (n *Node) hasFreeSlot() bool {
+	return n.slots == nil || len(n.slots) < cap(n.slots)
+}
+
+// acquire takes a slot of the node, the call waits in the queue of the node if all the slots are taken.
+// The returned func should be called to release the slot.
+func (n *Node) acquire(ctx context.Context) (func(), error) {
+	if n.slots == nil {
+		return func() {}, nil
+	}
+
+	release := func() { <-n.slots }
+
+	select {
+	case n.slots <- struct{}{}:
+		return release, nil
+	default:
+	}
+
+	waiting := n.waiting.Add(1)
+	if int(waiting) > n.opt.QueueSize {
+		n.waiting.Add(-1)
+		return nil, fmt.Errorf("%w: %s, %d calls in flight and %d queued", ErrNodeBusy, n.Addr, cap(n.slots), n.opt.QueueSize)
+	}
+
+	mctx, _ := tag.New(ctx, tag.Upsert(nodeKey, n.Addr))
+	nodeQueueDepth.Set(mctx, waiting)
+	stopWatch := nodeQueueWait.Start()
+	defer func() {
+		nodeQueueDepth.Set(mctx, n.waiting.Add(-1))
+		stopWatch(mctx)
+	}()
+
+	timer := time.NewTimer(n.opt.QueueTimeout)
+	defer timer.Stop()
+
+	select {
+	case n.slots <- struct{}{}:
+		return release, nil
+
+	case <-timer.C:
+		return nil, fmt.Errorf("%w: %s, waited for a free slot for %s", ErrNodeBusy, n.Addr, n.opt.QueueTimeout)
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+type nodeRecorderKey struct{}
+
+// WithNodeRecorder returns a context, with which the calls to the upstream nodes report the addresses of the nodes to record.
+// record may be called concurrently, e.g. by the aggregated gas estimations.
+func WithNodeRecorder(ctx context.Context, record func(addr string)) context.Context {
+	return context.WithValue(ctx, nodeRecorderKey{}, record)
+}
+
+// Load returns the cost of the calls in flight and queued on the node, see package cost
+func (n *Node) Load() int64 {
+	return n.load.Load()
+}
+
+// wrapUpstream wraps the client, so that the calls in flight are limited by the slots of the node,
+// the costs of the calls are counted in the load of the node, and the metrics and the spans of the calls are recorded.
+// The span context is sent to the node in the meta of the json-rpc request, so that the traces go on in the node.
+func (n *Node) wrapUpstream(full v1api.FullNode) v1api.FullNode {
+	return apiwrap.FullNode(full, n.handleUpstream)
+}
+
+// wrapVenusUpstream wraps the client speaking the venus api as wrapUpstream does
+func (n *Node) wrapVenusUpstream(full v1.FullNode) v1.FullNode {
+	return apiwrap.VenusFullNode(full, n.handleUpstream)
+}
+
+// handleUpstream is the apiwrap.Handler of the calls to the node, see wrapUpstream
+func (n *Node) handleUpstream(method string, fn reflect.Value, args []reflect.Value) []reflect.Value {
+	ctx, span := trace.StartSpan(args[0].Interface().(context.Context), "co.upstream."+method)
+	defer span.End()
+	span.AddAttributes(trace.StringAttribute("node", n.Addr), trace.StringAttribute("method", method))
+	args[0] = reflect.ValueOf(ctx)
+
+	if record, ok := ctx.Value(nodeRecorderKey{}).(func(string)); ok {
+		record(n.Addr)
+	}
+	done := n.callMetrics(ctx, method)
+
+	c := int64(n.opt.Costs.Of(method))
+	n.load.Add(c)
+	defer n.load.Add(-c)
+	n.inFlight.Add(1)
+	defer n.inFlight.Add(-1)
+
+	release, err := n.acquire(ctx)
+	if err != nil {
+		done(err)
+		span.SetStatus(trace.Status{Code: trace.StatusCodeUnavailable, Message: err.Error()})
+		return apiwrap.ErrorResults(fn.Type(), err)
+	}
+	defer release()
+
+	start := time.Now()
+	out := fn.Call(args)
+	subscription := fn.Type().NumOut() > 0 && fn.Type().Out(0).Kind() == reflect.Chan
+	if !subscription {
+		n.observeLatency(time.Since(start))
+	}
+	err = apiwrap.ResultError(out)
+	done(err)
+	if err != nil {
+		span.SetStatus(trace.Status{Code: trace.StatusCodeUnknown, Message: err.Error()})
+		return out
+	}
+
+	if subscription && !out[0].IsNil() {
+		out[0] = n.trackSubscription(ctx, out[0])
+	}
+	return out
+}
+
+// trackSubscription forwards the elements of the channel returned by the node to a new channel,
+// the subscription is counted in InFlight until the node closes its channel.
+// The channel of the node is drained after ctx is done, since the client closes it then.
+func (n *Node) trackSubscription(ctx context.Context, src reflect.Value) reflect.Value {
+	dst := reflect.MakeChan(reflect.ChanOf(reflect.BothDir, src.Type().Elem()), 0)
+	n.subscriptions.Add(1)
+
+	go func() {
+		defer n.subscriptions.Add(-1)
+
+		defer func() {
+			for ok := true; ok; {
+				_, ok = src.Recv()
+			}
+		}()
+		defer dst.Close()
+
+		done := reflect.ValueOf(ctx.Done())
+		for {
+			chosen, elem, ok := reflect.Select([]reflect.SelectCase{
+				{Dir: reflect.SelectRecv, Chan: src},
+				{Dir: reflect.SelectRecv, Chan: done},
+			})
+			if chosen == 1 || !ok {
+				return
+			}
+
+			chosen, _, _ = reflect.Select([]reflect.SelectCase{
+				{Dir: reflect.SelectSend, Chan: dst, Send: elem},
+				{Dir: reflect.SelectRecv, Chan: done},
+			})
+			if chosen == 1 {
+				return
+			}
+		}
+	}()
+
+	return dst.Convert(src.Type())
+}
@@ -0,0 +1,106 @@
+package co
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/lotus/api/v1api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/trace"
+)
+
+type blockingFullNode struct {
+	v1api.FullNode
+	entered chan struct{}
+	unblock chan struct{}
+}
+
+func (b *blockingFullNode) ChainHead(context.Context) (*types.TipSet, error) {
+	b.entered <- struct{}{}
+	<-b.unblock
+	return &types.TipSet{}, nil
+}
+
+func TestNodeSlots(t *testing.T) {
+	upstream := &blockingFullNode{entered: make(chan struct{}, 8), unblock: make(chan struct{})}
+	node := &Node{
+		Addr:  "a",
+		opt:   NodeOption{QueueSize: 1, QueueTimeout: 300 * time.Millisecond},
+		slots: make(chan struct{}, 1),
+	}
+	full := node.wrapUpstream(upstream)
+
+	errCh := make(chan error, 4)
+	call := func() {
+		_, err := full.ChainHead(context.Background())
+		errCh <- err
+	}
+
+	go call()
+	<-upstream.entered
+	assert.False(t, node.hasFreeSlot())
+	assert.Equal(t, int64(1), node.Load())
+
+	// queued, then timed out
+	go call()
+	assert.ErrorIs(t, <-errCh, ErrNodeBusy)
+
+	// queued, and the queue is full
+	go call()
+	assert.Eventually(t, func() bool { return node.waiting.Load() == 1 }, time.Second, time.Millisecond)
+	_, err := full.ChainHead(context.Background())
+	assert.ErrorIs(t, err, ErrNodeBusy)
+
+	// the queued call goes on once the slot is released
+	upstream.unblock <- struct{}{}
+	assert.NoError(t, <-errCh)
+	<-upstream.entered
+	upstream.unblock <- struct{}{}
+	assert.NoError(t, <-errCh)
+	assert.True(t, node.hasFreeSlot())
+	assert.Equal(t, int64(0), node.Load())
+}
+
+type spanRecorder struct {
+	lk    sync.Mutex
+	spans []*trace.SpanData
+}
+
+func (r *spanRecorder) ExportSpan(s *trace.SpanData) {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	r.spans = append(r.spans, s)
+}
+
+func TestUpstreamSpan(t *testing.T) {
+	recorder := &spanRecorder{}
+	trace.RegisterExporter(recorder)
+	defer trace.UnregisterExporter(recorder)
+
+	node := &Node{Addr: "traced-node"}
+	full := node.wrapUpstream(&failingFullNode{})
+
+	ctx, parent := trace.StartSpan(context.Background(), "request", trace.WithSampler(trace.AlwaysSample()))
+	_, err := full.ChainHead(ctx)
+	assert.Error(t, err)
+	parent.End()
+
+	recorder.lk.Lock()
+	defer recorder.lk.Unlock()
+
+	var upstream *trace.SpanData
+	for _, s := range recorder.spans {
+		if s.Name == "co.upstream.ChainHead" {
+			upstream = s
+		}
+	}
+	assert.NotNil(t, upstream)
+	assert.Equal(t, parent.SpanContext().TraceID, upstream.TraceID)
+	assert.Equal(t, parent.SpanContext().SpanID, upstream.ParentSpanID)
+	assert.Equal(t, "traced-node", upstream.Attributes["node"])
+	assert.Equal(t, "ChainHead", upstream.Attributes["method"])
+	assert.Equal(t, int32(trace.StatusCodeUnknown), upstream.Status.Code)
+}
@@ -0,0 +1,138 @@
+package co
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/filecoin-project/go-jsonrpc"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/hashicorp/go-multierror"
+	"github.com/ipfs/go-cid"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+// StateSearchMsg impls api.FullNode.StateSearchMsg
+func (c *Coordinator) StateSearchMsg(ctx context.Context, from types.TipSetKey, msg cid.Cid, limit abi.ChainEpoch, allowReplaced bool) (*api.MsgLookup, error) {
+	var lookup *api.MsgLookup
+	err := c.callWithFailover(ctx, from, "StateSearchMsg", func(ctx context.Context, node *Node) error {
+		var err error
+		lookup, err = node.FullNode().StateSearchMsg(ctx, from, msg, limit, allowReplaced)
+		return err
+	})
+
+	return lookup, err
+}
+
+// StateWaitMsg impls api.FullNode.StateWaitMsg
+// Instead of blocking on a single upstream, it searches the message on every head change of the coordinator,
+// so that it survives the restart of any upstream node. The search is retried on the next head change
+// whatever the upstreams fail with, e.g. the nodes left behind don't know the head yet.
+func (c *Coordinator) StateWaitMsg(ctx context.Context, msg cid.Cid, confidence uint64, limit abi.ChainEpoch, allowReplaced bool) (*api.MsgLookup, error) {
+	subch := c.tspub.Sub(tipsetChangeTopic)
+	defer func() {
+		c.tspub.Unsub(subch)
+		for range subch {
+		}
+	}()
+
+	c.headMu.RLock()
+	head := c.head
+	c.headMu.RUnlock()
+
+	if head == nil {
+		return nil, ErrNoHead
+	}
+
+	start := head.Height()
+	for {
+		// the lookback limit is counted from the head when we started waiting
+		searchLimit := limit
+		if limit != api.LookbackNoLimit {
+			searchLimit = limit + head.Height() - start
+		}
+
+		lookup, err := c.StateSearchMsg(ctx, head.Key(), msg, searchLimit, allowReplaced)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			log.Warnf("StateWaitMsg: search msg %s at %d: %s, retry on next head change", msg, head.Height(), err)
+		} else if lookup != nil && head.Height() >= lookup.Height+abi.ChainEpoch(confidence) {
+			return lookup, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+
+		case <-c.ctx.lc.Done():
+			return nil, c.ctx.lc.Err()
+
+		case _, ok := <-subch:
+			if !ok {
+				return nil, fmt.Errorf("head change subscription closed")
+			}
+
+			c.headMu.RLock()
+			head = c.head
+			c.headMu.RUnlock()
+		}
+	}
+}
+
+// StateSearchMsgLimited impls v0api.FullNode.StateSearchMsgLimited
+func (c *Coordinator) StateSearchMsgLimited(ctx context.Context, msg cid.Cid, limit abi.ChainEpoch) (*api.MsgLookup, error) {
+	return c.StateSearchMsg(ctx, types.EmptyTSK, msg, limit, true)
+}
+
+// StateWaitMsgLimited impls v0api.FullNode.StateWaitMsgLimited
+func (c *Coordinator) StateWaitMsgLimited(ctx context.Context, msg cid.Cid, confidence uint64, limit abi.ChainEpoch) (*api.MsgLookup, error) {
+	return c.StateWaitMsg(ctx, msg, confidence, limit, true)
+}
+
+// callWithFailover calls the given func on the selected node, and on the other nodes in order
+// if the previous one fails, e.g. it's unreachable or it lags behind and doesn't know the tipset yet.
+// It returns an error wraps ErrNoNodeAvailable if none of the nodes is reachable,
+// or the errors of all the nodes if every node fails.
+func (c *Coordinator) callWithFailover(ctx context.Context, tsk types.TipSetKey, method string, call func(context.Context, *Node) error) error {
+	nodes := c.sel.SelectN(ctx, tsk, 0, ErrPriority)
+	if len(nodes) == 0 {
+		return ErrNoNodeAvailable
+	}
+
+	var errs *multierror.Error
+	unreachable := true
+	for _, node := range nodes {
+		err := call(ctx, node)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return err
+		}
+
+		if isConnectionError(err) {
+			log.Warnf("%s: node %s is unreachable, try the next one: %s", method, node.Addr, err)
+			c.sel.setPriority(ErrPriority, node.Addr)
+		} else {
+			log.Warnf("%s: node %s failed, try the next one: %s", method, node.Addr, err)
+			unreachable = false
+		}
+		errs = multierror.Append(errs, fmt.Errorf("%s: %w", node.Addr, err))
+	}
+
+	if unreachable {
+		return fmt.Errorf("%w: %s", ErrNoNodeAvailable, errs)
+	}
+	return errs
+}
+
+func isConnectionError(err error) bool {
+	var connErr *jsonrpc.RPCConnectionError
+	var cliErr *jsonrpc.ErrClient
+	return errors.As(err, &connErr) || errors.As(err, &cliErr)
+}
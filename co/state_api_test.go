@@ -0,0 +1,313 @@
+package co
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-jsonrpc"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/network"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/api/v1api"
+	"github.com/filecoin-project/lotus/chain/store"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/golang/mock/gomock"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/assert"
+)
+
+type searchMsgFunc func(ctx context.Context, from types.TipSetKey, msg cid.Cid, limit abi.ChainEpoch, allowReplaced bool) (*api.MsgLookup, error)
+
+// mockFullNode overrides the methods needed by the tests, others will panic
+type mockFullNode struct {
+	v1api.FullNode
+	searchMsg  searchMsgFunc
+	getActor   func(ctx context.Context, addr address.Address, tsk types.TipSetKey) (*types.Actor, error)
+	actorCodes map[string]cid.Cid
+	// netVersion is the network version reported by the node, Version26 if unset
+	netVersion   network.Version
+	decodeParams func(ctx context.Context, toAddr address.Address, method abi.MethodNum, params []byte, tsk types.TipSetKey) (interface{}, error)
+	encodeParams func(ctx context.Context, toActCode cid.Cid, method abi.MethodNum, params json.RawMessage) ([]byte, error)
+}
+
+func (m *mockFullNode) StateSearchMsg(ctx context.Context, from types.TipSetKey, msg cid.Cid, limit abi.ChainEpoch, allowReplaced bool) (*api.MsgLookup, error) {
+	return m.searchMsg(ctx, from, msg, limit, allowReplaced)
+}
+
+func (m *mockFullNode) StateGetActor(ctx context.Context, addr address.Address, tsk types.TipSetKey) (*types.Actor, error) {
+	return m.getActor(ctx, addr, tsk)
+}
+
+func (m *mockFullNode) StateNetworkVersion(context.Context, types.TipSetKey) (network.Version, error) {
+	if m.netVersion != 0 {
+		return m.netVersion, nil
+	}
+	return network.Version26, nil
+}
+
+func (m *mockFullNode) StateDecodeParams(ctx context.Context, toAddr address.Address, method abi.MethodNum, params []byte, tsk types.TipSetKey) (interface{}, error) {
+	return m.decodeParams(ctx, toAddr, method, params, tsk)
+}
+
+func (m *mockFullNode) StateEncodeParams(ctx context.Context, toActCode cid.Cid, method abi.MethodNum, params json.RawMessage) ([]byte, error) {
+	return m.encodeParams(ctx, toActCode, method, params)
+}
+
+func (m *mockFullNode) StateActorCodeCIDs(context.Context, network.Version) (map[string]cid.Cid, error) {
+	return m.actorCodes, nil
+}
+
+func genTipSet(t *testing.T, height abi.ChainEpoch) *types.TipSet {
+	blk := genBlockHeader(t)
+	blk.Height = height
+
+	ts, err := types.NewTipSet([]*types.BlockHeader{blk})
+	assert.NoError(t, err)
+	return ts
+}
+
+// setupCoordinator builds a coordinator with the given upstreams, all of them have caught up the head
+func setupCoordinator(t *testing.T, head *types.TipSet, fulls map[string]v1api.FullNode) *Coordinator {
+	ctrl := gomock.NewController(t)
+	nodeStore := NewMockINodeStore(ctrl)
+	sel, _ := NewSelector(&Ctx{lc: context.Background()}, nodeStore, nil)
+
+	nodes := make(map[string]*Node, len(fulls))
+	list := make([]*Node, 0, len(fulls))
+	for addr, full := range fulls {
+		blkCache, err := newBlockHeaderCache(20)
+		assert.NoError(t, err)
+		blkCache.add([]*api.HeadChange{{Val: head}})
+
+		node := &Node{Addr: addr, blkCache: blkCache}
+		node.upstream.full = full
+		nodes[addr] = node
+		list = append(list, node)
+	}
+
+	nodeStore.EXPECT().GetNode(gomock.Any()).AnyTimes().DoAndReturn(func(addr string) *Node {
+		return nodes[addr]
+	})
+	nodeStore.EXPECT().AddNodes(gomock.Any())
+	sel.AddNodes(list...)
+	for addr := range nodes {
+		sel.setPriority(CatchUpPriority, addr)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	c, err := NewCoordinator(&Ctx{lc: ctx}, head, types.NewInt(0), sel, nil)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		c.Stop() // nolint:errcheck
+	})
+
+	return c
+}
+
+func applyHead(c *Coordinator, ts *types.TipSet) {
+	c.headMu.Lock()
+	c.head = ts
+	c.headMu.Unlock()
+
+	c.tspub.Pub([]*api.HeadChange{{Type: store.HCApply, Val: ts}}, tipsetChangeTopic)
+}
+
+func TestStateSearchMsgFailover(t *testing.T) {
+	head := genTipSet(t, 100)
+	msg := head.Blocks()[0].Messages
+	lookup := &api.MsgLookup{Message: msg, Height: 99}
+
+	down := &mockFullNode{searchMsg: func(context.Context, types.TipSetKey, cid.Cid, abi.ChainEpoch, bool) (*api.MsgLookup, error) {
+		return nil, &jsonrpc.RPCConnectionError{}
+	}}
+	up := &mockFullNode{searchMsg: func(context.Context, types.TipSetKey, cid.Cid, abi.ChainEpoch, bool) (*api.MsgLookup, error) {
+		return lookup, nil
+	}}
+
+	c := setupCoordinator(t, head, map[string]v1api.FullNode{"a": down, "b": up})
+	for i := 0; i < 4; i++ {
+		found, err := c.StateSearchMsg(context.Background(), head.Key(), msg, api.LookbackNoLimit, true)
+		assert.NoError(t, err)
+		assert.Equal(t, lookup, found)
+	}
+
+	// unreachable node is moved to the lowest priority
+	assert.Equal(t, ErrPriority, c.sel.ListPriority()["a"])
+
+	c = setupCoordinator(t, head, map[string]v1api.FullNode{"a": down})
+	_, err := c.StateSearchMsg(context.Background(), head.Key(), msg, api.LookbackNoLimit, true)
+	assert.ErrorIs(t, err, ErrNoNodeAvailable)
+
+	// the other errors of the upstream go on with the next node as well
+	lagging := &mockFullNode{searchMsg: func(context.Context, types.TipSetKey, cid.Cid, abi.ChainEpoch, bool) (*api.MsgLookup, error) {
+		return nil, fmt.Errorf("tipset not found")
+	}}
+	c = setupCoordinator(t, head, map[string]v1api.FullNode{"a": lagging, "b": up})
+	for i := 0; i < 4; i++ {
+		found, err := c.StateSearchMsg(context.Background(), head.Key(), msg, api.LookbackNoLimit, true)
+		assert.NoError(t, err)
+		assert.Equal(t, lookup, found)
+	}
+	assert.Equal(t, CatchUpPriority, c.sel.ListPriority()["a"])
+
+	// the errors of all the nodes are returned after every node fails
+	c = setupCoordinator(t, head, map[string]v1api.FullNode{"a": lagging, "b": down})
+	_, err = c.StateSearchMsg(context.Background(), head.Key(), msg, api.LookbackNoLimit, true)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "a: tipset not found")
+	assert.Contains(t, err.Error(), "b: ")
+}
+
+func TestStateWaitMsg(t *testing.T) {
+	head := genTipSet(t, 100)
+	msg := head.Blocks()[0].Messages
+
+	searched := make(chan abi.ChainEpoch, 16)
+	var found *api.MsgLookup
+	full := &mockFullNode{searchMsg: func(_ context.Context, from types.TipSetKey, _ cid.Cid, limit abi.ChainEpoch, _ bool) (*api.MsgLookup, error) {
+		defer func() {
+			searched <- limit
+		}()
+		return found, nil
+	}}
+
+	c := setupCoordinator(t, head, map[string]v1api.FullNode{"a": full})
+
+	type result struct {
+		lookup *api.MsgLookup
+		err    error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		lookup, err := c.StateWaitMsg(context.Background(), msg, 2, 10, true)
+		resCh <- result{lookup: lookup, err: err}
+	}()
+
+	// not found in the lookback range
+	assert.Equal(t, abi.ChainEpoch(10), <-searched)
+
+	// found in the next head, but not confident enough
+	found = &api.MsgLookup{Message: msg, Height: 101}
+	next := genTipSet(t, 101)
+	c.sel.nodeProvider.GetNode("a").blkCache.add([]*api.HeadChange{{Val: next}})
+	applyHead(c, next)
+	assert.Equal(t, abi.ChainEpoch(11), <-searched)
+
+	select {
+	case <-resCh:
+		t.Fatal("should wait for the confidence")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	next = genTipSet(t, 103)
+	c.sel.nodeProvider.GetNode("a").blkCache.add([]*api.HeadChange{{Val: next}})
+	applyHead(c, next)
+	assert.Equal(t, abi.ChainEpoch(13), <-searched)
+
+	res := <-resCh
+	assert.NoError(t, res.err)
+	assert.Equal(t, found, res.lookup)
+}
+
+func TestStateWaitMsgResume(t *testing.T) {
+	head := genTipSet(t, 100)
+	msg := head.Blocks()[0].Messages
+	lookup := &api.MsgLookup{Message: msg, Height: 100}
+
+	// the caught-up node is restarting, the other one lags behind until it catches up
+	var caughtUp atomic.Bool
+	searched := make(chan struct{}, 16)
+	down := &mockFullNode{searchMsg: func(context.Context, types.TipSetKey, cid.Cid, abi.ChainEpoch, bool) (*api.MsgLookup, error) {
+		return nil, &jsonrpc.RPCConnectionError{}
+	}}
+	lagging := &mockFullNode{searchMsg: func(context.Context, types.TipSetKey, cid.Cid, abi.ChainEpoch, bool) (*api.MsgLookup, error) {
+		defer func() {
+			searched <- struct{}{}
+		}()
+		if !caughtUp.Load() {
+			return nil, fmt.Errorf("tipset not found")
+		}
+		return lookup, nil
+	}}
+
+	c := setupCoordinator(t, head, map[string]v1api.FullNode{"a": down, "b": lagging})
+
+	type result struct {
+		lookup *api.MsgLookup
+		err    error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		lookup, err := c.StateWaitMsg(context.Background(), msg, 0, api.LookbackNoLimit, true)
+		resCh <- result{lookup: lookup, err: err}
+	}()
+
+	<-searched
+	select {
+	case res := <-resCh:
+		t.Fatalf("should wait for the next head, got %v, %v", res.lookup, res.err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	caughtUp.Store(true)
+	next := genTipSet(t, 101)
+	for _, addr := range []string{"a", "b"} {
+		c.sel.nodeProvider.GetNode(addr).blkCache.add([]*api.HeadChange{{Val: next}})
+	}
+	applyHead(c, next)
+
+	res := <-resCh
+	assert.NoError(t, res.err)
+	assert.Equal(t, lookup, res.lookup)
+}
+
+func TestStateMsgLimited(t *testing.T) {
+	head := genTipSet(t, 100)
+	msg := head.Blocks()[0].Messages
+	lookup := &api.MsgLookup{Message: msg, Height: 95}
+
+	type searchArgs struct {
+		from          types.TipSetKey
+		limit         abi.ChainEpoch
+		allowReplaced bool
+	}
+	searched := make(chan searchArgs, 16)
+	full := &mockFullNode{searchMsg: func(_ context.Context, from types.TipSetKey, _ cid.Cid, limit abi.ChainEpoch, allowReplaced bool) (*api.MsgLookup, error) {
+		searched <- searchArgs{from: from, limit: limit, allowReplaced: allowReplaced}
+		if limit < 100-lookup.Height {
+			return nil, nil
+		}
+		return lookup, nil
+	}}
+
+	c := setupCoordinator(t, head, map[string]v1api.FullNode{"a": full})
+
+	found, err := c.StateSearchMsgLimited(context.Background(), msg, 3)
+	assert.NoError(t, err)
+	assert.Nil(t, found)
+	assert.Equal(t, searchArgs{from: types.EmptyTSK, limit: 3, allowReplaced: true}, <-searched)
+
+	found, err = c.StateSearchMsgLimited(context.Background(), msg, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, lookup, found)
+	assert.Equal(t, searchArgs{from: types.EmptyTSK, limit: 5, allowReplaced: true}, <-searched)
+
+	found, err = c.StateWaitMsgLimited(context.Background(), msg, 5, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, lookup, found)
+	assert.Equal(t, searchArgs{from: head.Key(), limit: 5, allowReplaced: true}, <-searched)
+
+	// out of the lookback limit, keep waiting
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = c.StateWaitMsgLimited(ctx, msg, 0, 3)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, searchArgs{from: head.Key(), limit: 3, allowReplaced: true}, <-searched)
+}
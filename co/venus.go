@@ -0,0 +1,60 @@
+package co
+
+import (
+	"github.com/filecoin-project/go-jsonrpc"
+	v1 "github.com/filecoin-project/venus/venus-shared/api/chain/v1"
+)
+
+// VenusFullNode returns the client speaking the venus api, which is limited by the slots of the node as FullNode does.
+// It's nil unless the node is found to be a venus node.
+func (n *Node) VenusFullNode() v1.FullNode {
+	n.upstreamLk.RLock()
+	defer n.upstreamLk.RUnlock()
+
+	return n.upstream.venus.limited
+}
+
+// connectVenus dials the node with the venus api and replaces the venus client, the closer of the replaced one is returned
+func (n *Node) connectVenus() (jsonrpc.ClientCloser, error) {
+	info := n.info
+	addr, err := info.DialArgs(info.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	full, closer, err := v1.NewFullNodeRPC(n.ctx, addr, info.AuthHeader())
+	if err != nil {
+		return nil, err
+	}
+
+	n.upstreamLk.Lock()
+	defer n.upstreamLk.Unlock()
+
+	prev := n.upstream.venus.closer
+	n.upstream.venus.closer = closer
+	n.upstream.venus.limited = n.wrapVenusUpstream(full)
+	return prev, nil
+}
+
+// syncVenusClient dials the venus client once the node is found to be a venus node,
+// and drops it if the node is replaced by another implementation
+func (n *Node) syncVenusClient(impl string) {
+	if (impl == ImplVenus) == (n.VenusFullNode() != nil) {
+		return
+	}
+
+	if impl == ImplVenus {
+		if _, err := n.connectVenus(); err != nil {
+			n.log.Warnf("connect with the venus api: %s", err)
+		}
+		return
+	}
+
+	n.upstreamLk.Lock()
+	closer := n.upstream.venus.closer
+	n.upstream.venus.closer, n.upstream.venus.limited = nil, nil
+	n.upstreamLk.Unlock()
+	if closer != nil {
+		closer()
+	}
+}
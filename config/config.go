@@ -1,7 +1,11 @@
 package config
 
 import (
+	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
+	"time"
 
 	"github.com/ipfs-force-community/metrics"
 	"github.com/pelletier/go-toml"
@@ -10,6 +14,7 @@ import (
 const (
 	ConfigFile = "config.toml"
 	TokenFile  = "token"
+	HeadFile   = "head.json"
 )
 
 type APIConfig struct {
@@ -20,21 +25,153 @@ type AuthConfig struct {
 	URL, Token string
 }
 
+// NodeConfig is an upstream node, Labels are the pools it belongs to.
+// Nodes without labels are in the shared "default" pool.
 type NodeConfig struct {
 	TokenURL string
+	// Name is a friendly name of the node shown by the cli
+	Name   string
+	Labels []string
+	// MaxInFlight overrides Upstream.MaxInFlight for this node if positive
+	MaxInFlight int
 }
 
+// UpstreamConfig limits the calls in flight of each node, 0 means unlimited.
+// Calls beyond the limit wait in a queue of QueueSize for at most QueueTimeout.
+type UpstreamConfig struct {
+	MaxInFlight  int
+	QueueSize    int
+	QueueTimeout time.Duration
+	// Network is the expected network name of the nodes, e.g. testnetnet for the mainnet, calibrationnet for the calibration net.
+	// The nodes on other networks are refused, the network of the first connected node is expected if it's empty.
+	Network string
+}
+
+// PoolConfig maps sophon-auth users to the node pools, users not listed use the "default" pool.
+// The calls of a user fail if none of the nodes in its pool is available,
+// unless the pool is listed in Fallback, whose users use the "default" pool then.
+type PoolConfig struct {
+	Users    map[string]string
+	Fallback []string
+}
+
+// HealthConfig is the thresholds of /readyz, sophon-co is ready when at least MinCaughtUp nodes are caught up,
+// and the head is at most MaxHeadDrift behind the wall clock.
+type HealthConfig struct {
+	MinCaughtUp  int
+	MaxHeadDrift time.Duration
+}
+
+// RateLimitConfig limits the requests with redis and the limits in sophon-auth when Redis and Auth.URL are set,
+// otherwise with the in-process token buckets, which are disabled when all the Rate are zero.
 type RateLimitConfig struct {
 	Redis string
+
+	// Global limits all the requests
+	Global LimitConfig
+	// Caller is the default limit of each user, token or host
+	Caller LimitConfig
+	// Callers overrides the limit of the given users or tokens
+	Callers map[string]LimitConfig
+	// Methods limits the requests of the given methods
+	Methods map[string]LimitConfig
+}
+
+// LimitConfig is a token bucket, Rate requests per second are allowed, with bursts up to Burst
+type LimitConfig struct {
+	Rate  float64
+	Burst int
+}
+
+// CostConfig overrides the costs of the methods, see cost.DefaultCosts, the methods not listed cost 1.
+// The costs are charged to the rate limits, both the in-process and the redis one, and balanced between the upstream nodes.
+type CostConfig struct {
+	Methods map[string]int
+}
+
+// ACLConfig points to the access control policy file, see acl/policy_example.toml.
+// The policy is disabled if PolicyFile is empty.
+type ACLConfig struct {
+	PolicyFile string
+}
+
+// AccessLogConfig writes a json line for each rpc call to Path, a relative path is in the repo.
+// The file is rotated when it exceeds MaxSize MB, and at most MaxBackups rotated files are kept.
+// Level is the default level of the methods, one of "none", "basic" and "full", where "full" also logs the size
+// of the responses, Methods overrides the level of the methods matching the patterns.
+// The access log is disabled if Path is empty.
+type AccessLogConfig struct {
+	Path       string
+	MaxSize    int
+	MaxBackups int
+	Level      string
+	Methods    map[string]string
+}
+
+// FilePath returns the path of the access log, relative to the repo if it's not absolute
+func (c AccessLogConfig) FilePath(repoPath string) string {
+	if c.Path == "" || filepath.IsAbs(c.Path) {
+		return c.Path
+	}
+	return filepath.Join(repoPath, c.Path)
+}
+
+// GasEstimateConfig controls how the gas estimations are made.
+// When Aggregate is enabled, estimations are fanned out to at most Nodes caught-up nodes,
+// and the results are combined with the policies, one of "max", "min" and "median".
+type GasEstimateConfig struct {
+	Aggregate bool
+	Nodes     int
+	Timeout   time.Duration
+
+	GasLimitPolicy   string
+	GasFeeCapPolicy  string
+	GasPremiumPolicy string
+}
+
+// NetworkConfig is a group of nodes on another network, which has its own coordinator.
+// Its apis are served under /<Name>, e.g. /calibnet/rpc/v1 and /calibnet/readyz.
+// Upstream and Health of the top level are used if they are not set.
+type NetworkConfig struct {
+	Name     string
+	Nodes    []NodeConfig
+	Upstream *UpstreamConfig
+	Health   *HealthConfig
+}
+
+// PathPrefix is the prefix of the paths of the apis, the one of the top level network is empty
+func (c NetworkConfig) PathPrefix() string {
+	if c.Name == "" {
+		return ""
+	}
+	return "/" + c.Name
 }
 
+// HeadFilePath returns the path of the persisted head of the network in the repo
+func (c NetworkConfig) HeadFilePath(repoPath string) string {
+	if c.Name == "" {
+		return filepath.Join(repoPath, HeadFile)
+	}
+	return filepath.Join(repoPath, c.Name+"-"+HeadFile)
+}
+
+var networkNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
 type Config struct {
-	API       APIConfig
-	Auth      AuthConfig
-	Nodes     []NodeConfig
-	RateLimit RateLimitConfig
-	Metrics   *metrics.MetricsConfig
-	Trace     *metrics.TraceConfig
+	API         APIConfig
+	Auth        AuthConfig
+	Nodes       []NodeConfig
+	Upstream    UpstreamConfig
+	Networks    []NetworkConfig
+	Pool        PoolConfig
+	Health      HealthConfig
+	RateLimit   RateLimitConfig
+	Cost        CostConfig
+	ACL         ACLConfig
+	AccessLog   AccessLogConfig
+	GasEstimate GasEstimateConfig
+	Metrics     *metrics.MetricsConfig
+	Trace       *metrics.TraceConfig
 }
 
 func DefaultConfig() *Config {
@@ -42,7 +179,30 @@ func DefaultConfig() *Config {
 		API: APIConfig{
 			ListenAddress: "0.0.0.0:1234",
 		},
-		Auth:    AuthConfig{},
+		Auth: AuthConfig{},
+		Upstream: UpstreamConfig{
+			MaxInFlight:  0,
+			QueueSize:    256,
+			QueueTimeout: 10 * time.Second,
+		},
+		Health: HealthConfig{
+			MinCaughtUp:  1,
+			MaxHeadDrift: 2 * time.Minute,
+		},
+		AccessLog: AccessLogConfig{
+			Path:       "",
+			MaxSize:    100,
+			MaxBackups: 5,
+			Level:      "basic",
+		},
+		GasEstimate: GasEstimateConfig{
+			Aggregate:        false,
+			Nodes:            3,
+			Timeout:          3 * time.Second,
+			GasLimitPolicy:   "max",
+			GasFeeCapPolicy:  "median",
+			GasPremiumPolicy: "median",
+		},
 		Metrics: metrics.DefaultMetricsConfig(),
 		Trace:   metrics.DefaultTraceConfig(),
 	}
@@ -54,6 +214,52 @@ func DefaultConfig() *Config {
 	return cfg
 }
 
+// NetworkList returns the top level network with an empty name and the ones in Networks,
+// the top level Upstream and Health are filled if they are not set.
+func (cfg *Config) NetworkList() []NetworkConfig {
+	list := make([]NetworkConfig, 0, len(cfg.Networks)+1)
+	list = append(list, NetworkConfig{
+		Nodes:    cfg.Nodes,
+		Upstream: &cfg.Upstream,
+		Health:   &cfg.Health,
+	})
+	for _, network := range cfg.Networks {
+		if network.Upstream == nil {
+			network.Upstream = &cfg.Upstream
+		}
+		if network.Health == nil {
+			network.Health = &cfg.Health
+		}
+		list = append(list, network)
+	}
+	return list
+}
+
+// Network returns the network of the name, the top level one is returned if the name is empty
+func (cfg *Config) Network(name string) (NetworkConfig, error) {
+	for _, network := range cfg.NetworkList() {
+		if network.Name == name {
+			return network, nil
+		}
+	}
+	return NetworkConfig{}, fmt.Errorf("network %s is not found in the config", name)
+}
+
+// CheckNetworks returns an error if any name of Networks is invalid or duplicated
+func (cfg *Config) CheckNetworks() error {
+	seen := make(map[string]struct{}, len(cfg.Networks))
+	for _, network := range cfg.Networks {
+		if !networkNameRegexp.MatchString(network.Name) || network.Name == "rpc" {
+			return fmt.Errorf("invalid network name %q", network.Name)
+		}
+		if _, ok := seen[network.Name]; ok {
+			return fmt.Errorf("duplicated network name %s", network.Name)
+		}
+		seen[network.Name] = struct{}{}
+	}
+	return nil
+}
+
 func NodeList(nodes []NodeConfig) []string {
 	var list []string
 	for _, node := range nodes {
@@ -68,7 +274,8 @@ func ReadConfig(filePath string) (*Config, error) {
 		return nil, err
 	}
 
-	cfg := &Config{}
+	// start from the default config, so that sections missing in old config files get sane values
+	cfg := DefaultConfig()
 	err = toml.Unmarshal(data, cfg)
 
 	return cfg, err
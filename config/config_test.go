@@ -12,10 +12,54 @@ func TestConfig(t *testing.T) {
 		{TokenURL: "token:/ip4/127.0.0.1/tcp/3453"},
 		{TokenURL: "token:/ip4/127.0.0.1/tcp/3453"},
 	}...)
+	cfg.Networks = append(cfg.Networks, NetworkConfig{
+		Name:  "calibnet",
+		Nodes: []NodeConfig{{TokenURL: "token:/ip4/127.0.0.1/tcp/3454"}},
+	})
 	cfg.Auth.URL = "http://127.0.0.1:8989"
 	cfg.RateLimit.Redis = "http://127.0.0.1:6379"
 	cfg.Trace.JaegerEndpoint = "http://127.0.0.1:14268/api/traces"
 
 	err := WriteConfig("./config_example.toml", cfg)
 	assert.NoError(t, err)
+
+	read, err := ReadConfig("./config_example.toml")
+	assert.NoError(t, err)
+	assert.Len(t, read.Networks, 1)
+	assert.Nil(t, read.Networks[0].Upstream)
+	assert.Equal(t, cfg.Upstream, *read.NetworkList()[1].Upstream)
+}
+
+func TestNetworks(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Health.MinCaughtUp = 2
+	upstream := UpstreamConfig{MaxInFlight: 8, Network: "calibrationnet"}
+	cfg.Networks = []NetworkConfig{
+		{Name: "calibnet", Upstream: &upstream},
+		{Name: "butterfly"},
+	}
+	assert.NoError(t, cfg.CheckNetworks())
+
+	list := cfg.NetworkList()
+	assert.Len(t, list, 3)
+	assert.Equal(t, "", list[0].PathPrefix())
+	assert.Equal(t, "/calibnet", list[1].PathPrefix())
+	assert.Equal(t, 8, list[1].Upstream.MaxInFlight)
+	assert.Equal(t, 2, list[1].Health.MinCaughtUp)
+	assert.Equal(t, cfg.Upstream, *list[2].Upstream)
+	assert.Equal(t, "/repo/head.json", list[0].HeadFilePath("/repo"))
+	assert.Equal(t, "/repo/calibnet-head.json", list[1].HeadFilePath("/repo"))
+
+	network, err := cfg.Network("calibnet")
+	assert.NoError(t, err)
+	assert.Equal(t, "calibnet", network.Name)
+	_, err = cfg.Network("mainnet")
+	assert.Error(t, err)
+
+	cfg.Networks = append(cfg.Networks, NetworkConfig{Name: "calibnet"})
+	assert.Error(t, cfg.CheckNetworks())
+	cfg.Networks = []NetworkConfig{{Name: "calib/net"}}
+	assert.Error(t, cfg.CheckNetworks())
+	cfg.Networks = []NetworkConfig{{Name: "rpc"}}
+	assert.Error(t, cfg.CheckNetworks())
 }
@@ -0,0 +1,71 @@
+// Package cost keeps the relative costs of the api methods, a call of ChainHead costs 1.
+package cost
+
+// DefaultCost is the cost of the methods not in the table
+const DefaultCost = 1
+
+// DefaultCosts are the costs of the known heavy methods
+var DefaultCosts = map[string]int{
+	"ChainExport":                1000,
+	"StateMarketDeals":           1000,
+	"StateGetAllAllocations":     500,
+	"StateGetAllClaims":          500,
+	"StateListMiners":            100,
+	"StateListActors":            100,
+	"StateMarketParticipants":    100,
+	"StateCompute":               100,
+	"StateMinerSectors":          50,
+	"StateMinerActiveSectors":    50,
+	"StateReplay":                50,
+	"StateListMessages":          50,
+	"EthGetLogs":                 50,
+	"StateGetClaims":             20,
+	"StateGetAllocations":        20,
+	"GasBatchEstimateMessageGas": 20,
+	"StateCall":                  10,
+	"StateSearchMsg":             10,
+	"StateMinerPartitions":       10,
+	"StateMinerFaults":           10,
+	"StateMinerRecoveries":       10,
+	"GasEstimateMessageGas":      10,
+	"EthCall":                    10,
+	"EthEstimateGas":             10,
+	"StateMinerDeadlines":        5,
+	"ChainGetPath":               5,
+}
+
+// New constructs a Table with the default costs and the overrides,
+// a non-positive override resets the method to DefaultCost.
+func New(overrides map[string]int) *Table {
+	costs := make(map[string]int, len(DefaultCosts)+len(overrides))
+	for method, c := range DefaultCosts {
+		costs[method] = c
+	}
+
+	for method, c := range overrides {
+		if c <= 0 {
+			delete(costs, method)
+			continue
+		}
+		costs[method] = c
+	}
+
+	return &Table{costs: costs}
+}
+
+// Table is the costs of the methods, it's read-only after being constructed
+type Table struct {
+	costs map[string]int
+}
+
+// Of returns the cost of the method, a nil Table counts all the methods as DefaultCost
+func (t *Table) Of(method string) int {
+	if t == nil {
+		return DefaultCost
+	}
+
+	if c, ok := t.costs[method]; ok {
+		return c
+	}
+	return DefaultCost
+}
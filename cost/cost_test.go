@@ -0,0 +1,20 @@
+package cost
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable(t *testing.T) {
+	table := New(map[string]int{"ChainHead": 2, "StateListMiners": 0, "StateCall": 30})
+
+	assert.Equal(t, 2, table.Of("ChainHead"))
+	assert.Equal(t, DefaultCost, table.Of("StateListMiners"))
+	assert.Equal(t, 30, table.Of("StateCall"))
+	assert.Equal(t, DefaultCosts["StateMarketDeals"], table.Of("StateMarketDeals"))
+	assert.Equal(t, DefaultCost, table.Of("ChainGetTipSet"))
+
+	var nilTable *Table
+	assert.Equal(t, DefaultCost, nilTable.Of("StateMarketDeals"))
+}
@@ -0,0 +1,505 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// sections are the interfaces the methods of the upstream api are classified into
+var sections = []string{"Proxy", "Local", "UnSupport"}
+
+// method is a method of the upstream api interface
+type method struct {
+	name string
+	doc  []string
+	perm string
+	typ  *ast.FuncType
+	fset *token.FileSet
+	// imports maps the names of the imports of the file declaring the method to their paths
+	imports map[string]string
+	// pkgPath is the path of the package declaring the method
+	pkgPath string
+}
+
+// hasContext returns true if the first param is a context
+func (m *method) hasContext() bool {
+	params := m.typ.Params.List
+	if len(params) == 0 {
+		return false
+	}
+
+	sel, ok := params[0].Type.(*ast.SelectorExpr)
+	return ok && sel.Sel.Name == "Context" && m.imports[fmt.Sprint(sel.X)] == "context"
+}
+
+// hasParam returns true if any of the params is of a type whose name contains typeName
+func (m *method) hasParam(typeName string) bool {
+	for _, param := range m.typ.Params.List {
+		if strings.Contains(exprString(param.Type), typeName) {
+			return true
+		}
+	}
+	return false
+}
+
+func exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	_ = printer.Fprint(&buf, token.NewFileSet(), expr)
+	return buf.String()
+}
+
+// propose classifies a new method by its perm tag and the types of its params, the reason is returned as well
+func propose(m *method) (string, string) {
+	if !m.hasContext() {
+		return "UnSupport", "takes no context, which the permission check and the wrappers require"
+	}
+
+	switch m.perm {
+	case "read":
+		if strings.HasPrefix(m.name, "Net") {
+			return "UnSupport", "describes the libp2p host of a single upstream"
+		}
+		return "Proxy", "read-only"
+
+	case "write":
+		if m.hasParam("TipSetKey") || m.hasParam("Message") || strings.HasPrefix(m.name, "Eth") {
+			return "Proxy", "writes to the chain shared by the upstreams"
+		}
+		return "UnSupport", "writes to a single upstream"
+
+	default:
+		return "UnSupport", fmt.Sprintf("requires the %s permission of a single upstream", m.perm)
+	}
+}
+
+// loadInterface collects the methods of the interface in the package, including the ones of the embedded interfaces
+func loadInterface(pkgPath string, name string) ([]*method, error) {
+	pkg, err := build.Import(pkgPath, ".", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	for _, fname := range pkg.GoFiles {
+		file, err := parser.ParseFile(fset, pkg.Dir+"/"+fname, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+
+		spec := findType(file, name)
+		if spec == nil {
+			continue
+		}
+
+		imports, err := importsOf(file)
+		if err != nil {
+			return nil, err
+		}
+
+		// the interface may be an alias of another one, e.g. the ones of lotus v0api
+		iface, ok := spec.Type.(*ast.InterfaceType)
+		if !ok {
+			return loadEmbedded(pkgPath, imports, spec.Type)
+		}
+
+		var methods []*method
+		for _, field := range iface.Methods.List {
+			typ, ok := field.Type.(*ast.FuncType)
+			if !ok {
+				embedded, err := loadEmbedded(pkgPath, imports, field.Type)
+				if err != nil {
+					return nil, err
+				}
+				methods = append(methods, embedded...)
+				continue
+			}
+
+			methods = append(methods, &method{
+				name:    field.Names[0].Name,
+				doc:     docOf(field.Doc),
+				perm:    permOf(field.Comment),
+				typ:     typ,
+				fset:    fset,
+				imports: imports,
+				pkgPath: pkgPath,
+			})
+		}
+		return methods, nil
+	}
+
+	return nil, fmt.Errorf("interface %s not found in %s", name, pkgPath)
+}
+
+// loadEmbedded loads the interface referred by the type expression in a file of the package
+func loadEmbedded(pkgPath string, imports map[string]string, expr ast.Expr) ([]*method, error) {
+	switch typ := expr.(type) {
+	case *ast.Ident:
+		return loadInterface(pkgPath, typ.Name)
+
+	case *ast.SelectorExpr:
+		path, ok := imports[fmt.Sprint(typ.X)]
+		if !ok {
+			return nil, fmt.Errorf("unknown package of interface %s.%s", typ.X, typ.Sel)
+		}
+		return loadInterface(path, typ.Sel.Name)
+
+	default:
+		return nil, fmt.Errorf("unexpected interface type %T in %s", typ, pkgPath)
+	}
+}
+
+func findType(file *ast.File, name string) *ast.TypeSpec {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			if ts := spec.(*ast.TypeSpec); ts.Name.Name == name {
+				return ts
+			}
+		}
+	}
+	return nil
+}
+
+// importsOf maps the names of the imports of the file to their paths
+func importsOf(file *ast.File) (map[string]string, error) {
+	imports := make(map[string]string, len(file.Imports))
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		name, err := nameOf(spec, path)
+		if err != nil {
+			return nil, err
+		}
+		imports[name] = path
+	}
+	return imports, nil
+}
+
+func nameOf(spec *ast.ImportSpec, path string) (string, error) {
+	if spec.Name != nil {
+		return spec.Name.Name, nil
+	}
+	return getPkgName(path)
+}
+
+func getPkgName(path string) (string, error) {
+	pkg, err := build.Import(path, ".", 0)
+	if err != nil {
+		return "", err
+	}
+	return pkg.Name, nil
+}
+
+func docOf(doc *ast.CommentGroup) []string {
+	if doc == nil {
+		return nil
+	}
+
+	lines := make([]string, 0, len(doc.List))
+	for _, c := range doc.List {
+		lines = append(lines, c.Text)
+	}
+	return lines
+}
+
+func permOf(comment *ast.CommentGroup) string {
+	if comment == nil {
+		return ""
+	}
+
+	for _, c := range comment.List {
+		if perm, ok := strings.CutPrefix(c.Text, "//perm:"); ok {
+			return strings.Fields(perm)[0]
+		}
+	}
+	return ""
+}
+
+// iface is an api interface of a package
+type iface struct {
+	pkgPath string
+	name    string
+}
+
+func (i iface) String() string {
+	return i.pkgPath + "." + i.name
+}
+
+// target is a file classifying the methods of an upstream api interface
+type target struct {
+	path     string
+	upstream iface
+	// compat are the interfaces whose methods are served on purpose although they're not in the upstream one,
+	// they're not reported as removed
+	compat []iface
+}
+
+// drift is the difference between the classification and the upstream api interface
+type drift struct {
+	added   []*method
+	removed []string
+}
+
+func (d drift) empty() bool {
+	return len(d.added) == 0 && len(d.removed) == 0
+}
+
+// classify compares the target with the upstream api interface, the new methods are added to the proposed sections
+// if write is true
+func classify(t target, write bool) (drift, error) {
+	var d drift
+
+	upstream, err := loadInterface(t.upstream.pkgPath, t.upstream.name)
+	if err != nil {
+		return d, fmt.Errorf("load %s: %w", t.upstream, err)
+	}
+
+	compat := map[string]struct{}{}
+	for _, i := range t.compat {
+		methods, err := loadInterface(i.pkgPath, i.name)
+		if err != nil {
+			return d, fmt.Errorf("load %s: %w", i, err)
+		}
+		for _, m := range methods {
+			compat[m.name] = struct{}{}
+		}
+	}
+
+	src, err := os.ReadFile(t.path)
+	if err != nil {
+		return d, err
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, t.path, src, parser.ParseComments)
+	if err != nil {
+		return d, err
+	}
+
+	classified := map[string]struct{}{}
+	closing := map[string]int{}
+	for _, section := range sections {
+		spec := findType(file, section)
+		if spec == nil {
+			return d, fmt.Errorf("interface %s not found in %s", section, t.path)
+		}
+		iface, ok := spec.Type.(*ast.InterfaceType)
+		if !ok {
+			return d, fmt.Errorf("%s of %s is not an interface", section, t.path)
+		}
+
+		closing[section] = fset.Position(iface.Methods.Closing).Offset
+		for _, field := range iface.Methods.List {
+			for _, name := range field.Names {
+				classified[name.Name] = struct{}{}
+			}
+		}
+	}
+
+	seen := map[string]struct{}{}
+	for _, m := range upstream {
+		seen[m.name] = struct{}{}
+		if _, ok := classified[m.name]; !ok {
+			d.added = append(d.added, m)
+		}
+	}
+	for name := range classified {
+		_, isCompat := compat[name]
+		if _, ok := seen[name]; !ok && !isCompat {
+			d.removed = append(d.removed, name)
+		}
+	}
+	sort.Strings(d.removed)
+
+	if !write || len(d.added) == 0 {
+		return d, nil
+	}
+
+	imports, err := importsOf(file)
+	if err != nil {
+		return d, err
+	}
+	names := map[string]string{}
+	for name, path := range imports {
+		names[path] = name
+	}
+
+	added := map[string]*bytes.Buffer{}
+	for _, m := range d.added {
+		section, reason := propose(m)
+		if added[section] == nil {
+			added[section] = &bytes.Buffer{}
+		}
+
+		code, err := m.render(names)
+		if err != nil {
+			return d, fmt.Errorf("render %s: %w", m.name, err)
+		}
+		fmt.Fprintf(added[section], "\n\t// gen/classify: %s, review the classification\n", reason)
+		for _, line := range m.doc {
+			fmt.Fprintf(added[section], "\t%s\n", line)
+		}
+		fmt.Fprintf(added[section], "\t%s\n", code)
+	}
+
+	// the methods are inserted before the closing braces, from the last one so that the offsets stay valid
+	offsets := make([]string, 0, len(added))
+	for section := range added {
+		offsets = append(offsets, section)
+	}
+	sort.Slice(offsets, func(i, j int) bool { return closing[offsets[i]] > closing[offsets[j]] })
+
+	out := append([]byte{}, src...)
+	for _, section := range offsets {
+		at := closing[section]
+		out = append(out[:at], append(added[section].Bytes(), out[at:]...)...)
+	}
+
+	out, err = addImports(t.path, out, imports, names)
+	if err != nil {
+		return d, err
+	}
+	return d, os.WriteFile(t.path, out, 0o644)
+}
+
+// render prints the method as a line of the interface, the types are qualified by the names of the imports of
+// the target, which are added to names if missing
+func (m *method) render(names map[string]string) (string, error) {
+	var renderErr error
+	qualify := func(c *astutil.Cursor) bool {
+		switch node := c.Node().(type) {
+		case *ast.SelectorExpr:
+			ident, ok := node.X.(*ast.Ident)
+			if !ok {
+				return false
+			}
+			path, ok := m.imports[ident.Name]
+			if !ok {
+				renderErr = fmt.Errorf("unknown package %s", ident.Name)
+				return false
+			}
+			name, err := importName(names, path)
+			if err != nil {
+				renderErr = err
+				return false
+			}
+			c.Replace(&ast.SelectorExpr{X: ast.NewIdent(name), Sel: node.Sel})
+			return false
+
+		case *ast.Ident:
+			// the exported types of the upstream package are used unqualified in its own files
+			if !unicode.IsUpper([]rune(node.Name)[0]) {
+				return false
+			}
+			name, err := importName(names, m.pkgPath)
+			if err != nil {
+				renderErr = err
+				return false
+			}
+			c.Replace(&ast.SelectorExpr{X: ast.NewIdent(name), Sel: node})
+			return false
+		}
+		return true
+	}
+
+	for _, fields := range []*ast.FieldList{m.typ.Params, m.typ.Results} {
+		if fields == nil {
+			continue
+		}
+		for _, field := range fields.List {
+			field.Type = astutil.Apply(field.Type, qualify, nil).(ast.Expr)
+		}
+	}
+	if renderErr != nil {
+		return "", renderErr
+	}
+
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, m.fset, m.typ); err != nil {
+		return "", err
+	}
+
+	line := m.name + strings.TrimPrefix(buf.String(), "func")
+	if m.perm != "" {
+		line += " //perm:" + m.perm
+	}
+	return line, nil
+}
+
+// importName returns the name of the import of the path, a name not taken yet is chosen if it's not imported
+func importName(names map[string]string, path string) (string, error) {
+	if name, ok := names[path]; ok {
+		return name, nil
+	}
+
+	pkgName, err := getPkgName(path)
+	if err != nil {
+		return "", err
+	}
+
+	taken := map[string]struct{}{}
+	for _, name := range names {
+		taken[name] = struct{}{}
+	}
+
+	name := pkgName
+	for i := 1; ; i++ {
+		if _, ok := taken[name]; !ok {
+			break
+		}
+		name = fmt.Sprintf("%s%d", pkgName, i)
+	}
+
+	names[path] = name
+	return name, nil
+}
+
+// addImports adds the imports in names missing in imports to the source, which is formatted then
+func addImports(path string, src []byte, imports map[string]string, names map[string]string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parse rewritten %s: %w", path, err)
+	}
+
+	for importPath, name := range names {
+		if imported, ok := imports[name]; ok && imported == importPath {
+			continue
+		}
+
+		pkgName, err := getPkgName(importPath)
+		if err != nil {
+			return nil, err
+		}
+		if name == pkgName {
+			astutil.AddImport(fset, file, importPath)
+		} else {
+			astutil.AddNamedImport(fset, file, name, importPath)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, file); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	check := flag.Bool("check", false, "report the drift without rewriting the files, exit with 1 if any")
+	flag.Parse()
+
+	targets := []target{
+		{
+			path:     "./api/api.go",
+			upstream: iface{pkgPath: "github.com/filecoin-project/lotus/api", name: "FullNode"},
+			// the v0 api is served by wrapping the v1 one, which serves the v0 only methods as well
+			compat: []iface{{pkgPath: "github.com/filecoin-project/lotus/api/v0api", name: "FullNode"}},
+		},
+		{
+			path:     "./api/venus/api.go",
+			upstream: iface{pkgPath: "github.com/filecoin-project/venus/venus-shared/api/chain/v1", name: "FullNode"},
+		},
+	}
+
+	drifted := false
+	for _, t := range targets {
+		d, err := classify(t, !*check)
+		if err != nil {
+			fmt.Println("ERR:", err)
+			os.Exit(1)
+		}
+
+		for _, m := range d.added {
+			section, reason := propose(m)
+			fmt.Printf("%s: new method %s of %s, proposed to %s: %s\n", t.path, m.name, t.upstream, section, reason)
+		}
+		for _, name := range d.removed {
+			fmt.Printf("%s: method %s is not in %s any more, remove it\n", t.path, name, t.upstream)
+		}
+		drifted = drifted || !d.empty()
+	}
+
+	if *check && drifted {
+		os.Exit(1)
+	}
+}
@@ -2,26 +2,33 @@ package main
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"go/build"
 	"reflect"
 	"strings"
 
-	"github.com/filecoin-project/lotus/chain/types"
 	"golang.org/x/tools/imports"
 )
 
 var errType = reflect.TypeOf((*error)(nil)).Elem()
-var tskType = reflect.TypeOf(types.EmptyTSK)
+var ctxType = reflect.TypeOf((*context.Context)(nil)).Elem()
 
-// Gen generates the impl code for given api interface
-func Gen(pkgName, structName string, api interface{}) ([]byte, error) {
-	gen := newGenerator(pkgName, structName)
+// rawMessageType may be an alias of a type in another package (e.g. encoding/json/jsontext),
+// we always want it to be written as json.RawMessage
+var rawMessageType = reflect.TypeOf(json.RawMessage{})
+
+// Gen generates the impl code for given api interface, the calls are routed by the params of type tsk,
+// which is the TipSetKey of lotus or venus
+func Gen(pkgName, structName string, api interface{}, tsk reflect.Type) ([]byte, error) {
+	gen := newGenerator(pkgName, structName, tsk)
 	if err := gen.register(reflect.TypeOf(api)); err != nil {
 		return nil, err
 	}
-	if structName == "Local" {
-		gen.deps["github.com/filecoin-project/lotus/chain/types"] = &depDef{}
+	// the Select func always takes the tipset key, even if none of the methods does
+	if _, err := gen.registerType(tsk); err != nil {
+		return nil, err
 	}
 
 	var buf bytes.Buffer
@@ -35,10 +42,10 @@ type apiInfo struct {
 	methods []*method
 }
 
-func (a *apiInfo) writeDef(structName string, buf *bytes.Buffer) {
+func (a *apiInfo) writeDef(structName string, tsk *genType, buf *bytes.Buffer) {
 	buf.WriteString(fmt.Sprintf("// impl %s\n", a.typ))
 	for _, meth := range a.methods {
-		meth.writeMethodDef(structName, buf)
+		meth.writeMethodDef(structName, tsk, buf)
 	}
 	buf.WriteString("\n\n")
 }
@@ -50,7 +57,7 @@ type method struct {
 	returnErr bool
 }
 
-func (m method) writeMethodDef(structName string, buf *bytes.Buffer) {
+func (m method) writeMethodDef(structName string, tsk *genType, buf *bytes.Buffer) {
 	inDefs := make([]string, 0, len(m.in))
 	inNames := make([]string, 0, len(m.in))
 	outDefs := make([]string, 0, len(m.out))
@@ -63,9 +70,18 @@ func (m method) writeMethodDef(structName string, buf *bytes.Buffer) {
 		inDefs = append(inDefs, def)
 	}
 
-	tskName := "types.EmptyTSK"
-	if m.in[len(m.in)-1].raw == tskType {
-		tskName = inNames[len(inNames)-1]
+	// route by the last tipset key param, if any
+	tskName := strings.TrimSuffix(tsk.String(), tsk.raw.Name()) + "EmptyTSK"
+	for i := range m.in {
+		if m.in[i].raw == tsk.raw {
+			tskName = inNames[i]
+		}
+	}
+
+	// the context carries the identity of the caller, which is used to choose the node pool
+	ctxName := "context.TODO()"
+	if len(m.in) > 0 && m.in[0].raw == ctxType {
+		ctxName = inNames[0]
 	}
 
 	for i := range m.out {
@@ -79,21 +95,22 @@ func (m method) writeMethodDef(structName string, buf *bytes.Buffer) {
 
 	buf.WriteString(fmt.Sprintf("func (p *%s) %s(%s) (%s) {\n", structName, m.name, strings.Join(inDefs, ", "), strings.Join(outDefs, ", ")))
 
-	buf.WriteString(fmt.Sprintf(`cli, err := p.Select(%s)
+	buf.WriteString(fmt.Sprintf(`cli, err := p.Select(%s, %s)
 	if err != nil {
 		err = fmt.Errorf("api %s %%v", err)
 		return
 	}
-	`, tskName, m.name))
+	`, ctxName, tskName, m.name))
 
 	buf.WriteString(fmt.Sprintf("return cli.%s(%s)", m.name, strings.Join(inNames, ", ")))
 	buf.WriteString("}\n\n")
 }
 
-func newGenerator(pname string, sname string) *generator {
+func newGenerator(pname string, sname string, tsk reflect.Type) *generator {
 	return &generator{
 		pkgName:    pname,
 		structName: sname,
+		tskType:    tsk,
 		apis:       make([]apiInfo, 0),
 
 		depCounter: map[string]int{},
@@ -105,6 +122,7 @@ func newGenerator(pname string, sname string) *generator {
 type generator struct {
 	pkgName    string
 	structName string
+	tskType    reflect.Type
 	apis       []apiInfo
 
 	depCounter map[string]int
@@ -149,13 +167,13 @@ func (g *generator) writeTypeAssertion(buf *bytes.Buffer) {
 
 func (g *generator) writeStructDef(buf *bytes.Buffer) {
 	buf.WriteString(fmt.Sprintf("type %s struct {\n", g.structName))
-	buf.WriteString(fmt.Sprintf("Select func(types.TipSetKey) (%sAPI, error)\n", g.structName))
+	buf.WriteString(fmt.Sprintf("Select func(context.Context, %s) (%sAPI, error)\n", g.types[g.tskType], g.structName))
 	buf.WriteString("}\n\n")
 }
 
 func (g *generator) writeImpls(buf *bytes.Buffer) {
 	for _, api := range g.apis {
-		api.writeDef(g.structName, buf)
+		api.writeDef(g.structName, g.types[g.tskType], buf)
 	}
 }
 
@@ -244,6 +262,19 @@ func (g *generator) registerType(t reflect.Type) (*genType, error) {
 }
 
 func (g *generator) parseGenType(raw reflect.Type) (*genType, error) {
+	if raw == rawMessageType {
+		dd, err := g.getDepDef("encoding/json")
+		if err != nil {
+			return nil, err
+		}
+
+		name := dd.origin
+		if dd.name != "" {
+			name = dd.name
+		}
+		return newGenType(raw, name+".RawMessage"), nil
+	}
+
 	pkgPath := raw.PkgPath()
 	if pkgPath != "" {
 		dd, err := g.getDepDef(pkgPath)
@@ -3,41 +3,80 @@ package main
 import (
 	"fmt"
 	"os"
+	"reflect"
+
+	"github.com/filecoin-project/lotus/chain/types"
+	vtypes "github.com/filecoin-project/venus/venus-shared/types"
 
 	"github.com/ipfs-force-community/sophon-co/api"
+	"github.com/ipfs-force-community/sophon-co/api/venus"
 )
 
 func main() {
-	pkgName := "proxy"
-
 	var proxy api.Proxy
 	var local api.Local
 	var unsupport api.UnSupport
 
+	var venusProxy venus.Proxy
+	var venusLocal venus.Local
+	var venusUnSupport venus.UnSupport
+
+	lotusTSK := reflect.TypeOf(types.EmptyTSK)
+	venusTSK := reflect.TypeOf(vtypes.EmptyTSK)
+
 	targets := []struct {
 		def        interface{}
+		pkgName    string
 		structName string
+		tsk        reflect.Type
 		outPath    string
 	}{
 		{
 			def:        &proxy,
+			pkgName:    "proxy",
 			structName: "Proxy",
+			tsk:        lotusTSK,
 			outPath:    "./proxy/proxy.go",
 		},
 		{
 			def:        &local,
+			pkgName:    "proxy",
 			structName: "Local",
+			tsk:        lotusTSK,
 			outPath:    "./proxy/local.go",
 		},
 		{
 			def:        &unsupport,
+			pkgName:    "proxy",
 			structName: "UnSupport",
+			tsk:        lotusTSK,
 			outPath:    "./proxy/unsupport.go",
 		},
+		{
+			def:        &venusProxy,
+			pkgName:    "venus",
+			structName: "Proxy",
+			tsk:        venusTSK,
+			outPath:    "./proxy/venus/proxy.go",
+		},
+		{
+			def:        &venusLocal,
+			pkgName:    "venus",
+			structName: "Local",
+			tsk:        venusTSK,
+			outPath:    "./proxy/venus/local.go",
+		},
+		{
+			def:        &venusUnSupport,
+			pkgName:    "venus",
+			structName: "UnSupport",
+			tsk:        venusTSK,
+			outPath:    "./proxy/venus/unsupport.go",
+		},
 	}
 
 	for _, t := range targets {
-		code, err := Gen(pkgName, t.structName, t.def)
+		code, err := Gen(t.pkgName, t.structName, t.def, t.tsk)
 		if err != nil {
 			fmt.Println("ERR:", err)
 			os.Exit(1)
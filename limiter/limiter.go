@@ -0,0 +1,228 @@
+package limiter
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/filecoin-project/go-jsonrpc"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/ipfs-force-community/sophon-auth/core"
+	logging "github.com/ipfs/go-log/v2"
+	"golang.org/x/time/rate"
+
+	"github.com/ipfs-force-community/sophon-co/acl"
+	"github.com/ipfs-force-community/sophon-co/apiwrap"
+	"github.com/ipfs-force-community/sophon-co/cost"
+)
+
+var log = logging.Logger("limiter")
+
+// ErrCodeRateLimited is the json-rpc error code of the limited calls, as the http status 429
+const ErrCodeRateLimited = jsonrpc.ErrorCode(429)
+
+const callerCacheSize = 10000
+
+// Limit is a token bucket, Rate tokens are added per second, up to Burst.
+// A Limit with non-positive Rate means unlimited.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+func (l Limit) enabled() bool {
+	return l.Rate > 0
+}
+
+func (l Limit) newBucket() *rate.Limiter {
+	burst := l.Burst
+	if burst < 1 {
+		burst = int(math.Ceil(l.Rate))
+	}
+	return rate.NewLimiter(rate.Limit(l.Rate), burst)
+}
+
+// Option configures the limits
+type Option struct {
+	// Global limits all the calls
+	Global Limit
+	// Caller is the default limit of each caller, a caller is identified by its user name, token, or host
+	Caller Limit
+	// Callers overrides the limit of the given user names or tokens
+	Callers map[string]Limit
+	// Methods limits the calls of the given methods from all the callers
+	Methods map[string]Limit
+	// Costs is the number of tokens taken by the calls of each method, nil takes 1 token for every call
+	Costs *cost.Table
+}
+
+// Enabled returns true if any limit is set
+func (o Option) Enabled() bool {
+	if o.Global.enabled() || o.Caller.enabled() {
+		return true
+	}
+
+	for _, l := range o.Callers {
+		if l.enabled() {
+			return true
+		}
+	}
+
+	for _, l := range o.Methods {
+		if l.enabled() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LimitedError is returned when the call is limited, it carries the json-rpc error code ErrCodeRateLimited
+type LimitedError struct {
+	Scope      string
+	RetryAfter time.Duration
+}
+
+func (e *LimitedError) Error() string {
+	return fmt.Sprintf("rate limited by %s limit, retry after %s", e.Scope, e.RetryAfter.Round(time.Millisecond))
+}
+
+func (e *LimitedError) Unwrap() error {
+	return ErrCodeRateLimited
+}
+
+// New constructs an in-process Limiter instance
+func New(opt Option) (*Limiter, error) {
+	callers, err := lru.New(callerCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &Limiter{
+		opt:     opt,
+		methods: make(map[string]*rate.Limiter, len(opt.Methods)),
+		callers: callers,
+	}
+
+	if opt.Global.enabled() {
+		l.global = opt.Global.newBucket()
+	}
+
+	for method, limit := range opt.Methods {
+		if limit.enabled() {
+			l.methods[method] = limit.newBucket()
+		}
+	}
+
+	return l, nil
+}
+
+// Limiter limits the calls with token buckets in memory, it works without redis and sophon-auth
+type Limiter struct {
+	opt Option
+
+	global  *rate.Limiter
+	methods map[string]*rate.Limiter
+	callers *lru.Cache
+}
+
+type scopedBucket struct {
+	scope  string
+	bucket *rate.Limiter
+}
+
+// Allow takes the tokens of the cost of the method from each of the buckets for the call,
+// or returns a LimitedError if any of them is short of tokens.
+// The cost is capped by the burst of the bucket, otherwise the call could never be made.
+func (l *Limiter) Allow(ctx context.Context, method string) error {
+	buckets := make([]scopedBucket, 0, 3)
+	if l.global != nil {
+		buckets = append(buckets, scopedBucket{scope: "global", bucket: l.global})
+	}
+
+	if bucket, ok := l.methods[method]; ok {
+		buckets = append(buckets, scopedBucket{scope: "method " + method, bucket: bucket})
+	}
+
+	if caller, bucket := l.callerBucket(ctx); bucket != nil {
+		buckets = append(buckets, scopedBucket{scope: "caller " + caller, bucket: bucket})
+	}
+
+	c := l.opt.Costs.Of(method)
+	now := time.Now()
+	reservations := make([]*rate.Reservation, 0, len(buckets))
+	var limited *LimitedError
+	for _, b := range buckets {
+		r := b.bucket.ReserveN(now, min(c, b.bucket.Burst()))
+		reservations = append(reservations, r)
+
+		delay := r.DelayFrom(now)
+		if !r.OK() {
+			delay = time.Second
+		}
+
+		if delay > 0 && (limited == nil || delay > limited.RetryAfter) {
+			limited = &LimitedError{Scope: b.scope, RetryAfter: delay}
+		}
+	}
+
+	if limited == nil {
+		return nil
+	}
+
+	// give the tokens back, since the call is not made
+	for _, r := range reservations {
+		r.CancelAt(now)
+	}
+
+	return limited
+}
+
+// callerBucket returns the name and the bucket of the caller, nil if the caller is not limited
+func (l *Limiter) callerBucket(ctx context.Context) (string, *rate.Limiter) {
+	caller := acl.CallerFromCtx(ctx)
+	host, _ := core.CtxGetTokenLocation(ctx)
+
+	limit := l.opt.Caller
+	key, name := host, host
+	if caller.Token != "" {
+		key, name = caller.Token, "token"
+	}
+	if caller.User != "" {
+		key, name = caller.User, caller.User
+	}
+
+	for _, id := range []string{caller.User, caller.Token} {
+		if custom, ok := l.opt.Callers[id]; ok && id != "" {
+			limit, key = custom, id
+			break
+		}
+	}
+
+	if !limit.enabled() || key == "" {
+		return "", nil
+	}
+
+	if bucket, ok := l.callers.Get(key); ok {
+		return name, bucket.(*rate.Limiter)
+	}
+
+	bucket := limit.newBucket()
+	l.callers.Add(key, bucket)
+	return name, bucket
+}
+
+// WrapFunctions wraps the methods of in into the func fields of out, which should be a pointer to
+// an Internal struct of the api proxy, as ratelimit.RateLimiter.WrapFunctions does.
+func (l *Limiter) WrapFunctions(in interface{}, out interface{}) {
+	apiwrap.WrapInternal(in, out, func(method string, fn reflect.Value, args []reflect.Value) []reflect.Value {
+		if err := l.Allow(args[0].Interface().(context.Context), method); err != nil {
+			log.Debugw("call limited", "method", method, "err", err)
+			return apiwrap.ErrorResults(fn.Type(), err)
+		}
+
+		return fn.Call(args)
+	})
+}
@@ -0,0 +1,119 @@
+package limiter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-jsonrpc"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs-force-community/sophon-auth/core"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ipfs-force-community/sophon-co/cost"
+)
+
+type mockFullNode struct {
+	api.FullNode
+}
+
+func (m *mockFullNode) ChainHead(context.Context) (*types.TipSet, error) {
+	return &types.TipSet{}, nil
+}
+
+func (m *mockFullNode) Version(context.Context) (api.APIVersion, error) {
+	return api.APIVersion{}, nil
+}
+
+func userCtx(user string) context.Context {
+	return core.CtxWithName(context.Background(), user)
+}
+
+func TestOptionEnabled(t *testing.T) {
+	assert.False(t, Option{}.Enabled())
+	assert.False(t, Option{Methods: map[string]Limit{"ChainHead": {}}}.Enabled())
+	assert.True(t, Option{Callers: map[string]Limit{"bob": {Rate: 1}}}.Enabled())
+}
+
+func TestLimiter(t *testing.T) {
+	l, err := New(Option{
+		Caller:  Limit{Rate: 0.001, Burst: 2},
+		Callers: map[string]Limit{"vip": {Rate: 1000, Burst: 1000}},
+		Methods: map[string]Limit{"Version": {Rate: 0.001, Burst: 1}},
+	})
+	assert.NoError(t, err)
+
+	var full api.FullNodeStruct
+	l.WrapFunctions(&mockFullNode{}, &full.Internal)
+	l.WrapFunctions(&mockFullNode{}, &full.CommonStruct.Internal)
+
+	for i := 0; i < 2; i++ {
+		_, err = full.ChainHead(userCtx("alice"))
+		assert.NoError(t, err)
+	}
+
+	_, err = full.ChainHead(userCtx("alice"))
+	var limited *LimitedError
+	assert.True(t, errors.As(err, &limited))
+	assert.Equal(t, "caller alice", limited.Scope)
+	assert.Greater(t, limited.RetryAfter, time.Minute)
+
+	// 429 is used as the json-rpc error code
+	var code jsonrpc.ErrorCode
+	assert.True(t, errors.As(err, &code))
+	assert.Equal(t, ErrCodeRateLimited, code)
+
+	// buckets of the callers are separated
+	for i := 0; i < 10; i++ {
+		_, err = full.ChainHead(userCtx("vip"))
+		assert.NoError(t, err)
+	}
+
+	// limited by the method, the token of the caller is given back
+	_, err = full.Version(userCtx("bob"))
+	assert.NoError(t, err)
+	_, err = full.Version(userCtx("bob"))
+	assert.True(t, errors.As(err, &limited))
+	assert.Equal(t, "method Version", limited.Scope)
+
+	_, err = full.ChainHead(userCtx("bob"))
+	assert.NoError(t, err)
+}
+
+func TestGlobalLimit(t *testing.T) {
+	l, err := New(Option{Global: Limit{Rate: 0.001, Burst: 1}})
+	assert.NoError(t, err)
+
+	assert.NoError(t, l.Allow(userCtx("alice"), "ChainHead"))
+
+	err = l.Allow(userCtx("bob"), "ChainHead")
+	var limited *LimitedError
+	assert.True(t, errors.As(err, &limited))
+	assert.Equal(t, "global", limited.Scope)
+}
+
+func TestCosts(t *testing.T) {
+	l, err := New(Option{
+		Caller: Limit{Rate: 0.001, Burst: 100},
+		Global: Limit{Rate: 0.001, Burst: 20},
+		Costs:  cost.New(map[string]int{"StateListMiners": 10}),
+	})
+	assert.NoError(t, err)
+
+	// 10 tokens are taken from both of the buckets
+	assert.NoError(t, l.Allow(userCtx("alice"), "StateListMiners"))
+	assert.NoError(t, l.Allow(userCtx("alice"), "StateListMiners"))
+
+	err = l.Allow(userCtx("alice"), "StateListMiners")
+	var limited *LimitedError
+	assert.True(t, errors.As(err, &limited))
+	assert.Equal(t, "global", limited.Scope)
+
+	// the cost is capped by the burst
+	l, err = New(Option{Caller: Limit{Rate: 0.001, Burst: 5}, Costs: cost.New(nil)})
+	assert.NoError(t, err)
+	assert.NoError(t, l.Allow(userCtx("alice"), "StateMarketDeals"))
+	assert.Error(t, l.Allow(userCtx("alice"), "ChainHead"))
+}
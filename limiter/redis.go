@@ -0,0 +1,87 @@
+package limiter
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"github.com/go-redis/redis_rate/v7"
+	"github.com/ipfs-force-community/metrics/ratelimit"
+	"github.com/ipfs-force-community/sophon-auth/core"
+	"go.opencensus.io/trace"
+
+	"github.com/ipfs-force-community/sophon-co/apiwrap"
+	"github.com/ipfs-force-community/sophon-co/cost"
+)
+
+// allowNFunc counts n events of name in the current period, allow is false if the count exceeds maxn
+type allowNFunc func(name string, maxn int64, period time.Duration, n int64) (count int64, delay time.Duration, allow bool)
+
+// NewRedis constructs a RedisLimiter counting the calls in the redis at endpoint
+func NewRedis(endpoint string, finder ratelimit.ILimitFinder, costs *cost.Table) *RedisLimiter {
+	limiter := redis_rate.NewLimiter(redis.NewRing(&redis.RingOptions{
+		Addrs: map[string]string{"server1": endpoint},
+	}))
+
+	return &RedisLimiter{
+		finder: finder,
+		costs:  costs,
+		allowN: limiter.AllowN,
+	}
+}
+
+// RedisLimiter limits the calls of each account by the limit set in sophon-auth, the calls are counted in redis,
+// so that the limits are shared by the instances. It works as ratelimit.RateLimiter of the metrics package,
+// except that a call is counted as the cost of its method instead of 1.
+type RedisLimiter struct {
+	finder ratelimit.ILimitFinder
+	costs  *cost.Table
+	allowN allowNFunc
+}
+
+// Allow counts the cost of the method for the account of the call, or returns a LimitedError if the limit is exceeded.
+// The cost is capped by the limit, otherwise the call could never be made.
+// The calls are allowed if the account or its limit is unknown, or redis is unavailable.
+func (l *RedisLimiter) Allow(ctx context.Context, method string) error {
+	user, ok := core.CtxGetName(ctx)
+	if !ok {
+		log.Warnw("rate limit: account of the call not found", "method", method)
+		return nil
+	}
+	trace.FromContext(ctx).AddAttributes(trace.StringAttribute("account", user))
+
+	limit, err := l.finder.GetUserLimit(user, "", "")
+	if err != nil {
+		log.Warnw("rate limit: get limit of the account", "user", user, "method", method, "err", err)
+		return nil
+	}
+	if limit.Cap <= 0 {
+		return nil
+	}
+
+	c := min(int64(l.costs.Of(method)), limit.Cap)
+	used, delay, allow := l.allowN(user, limit.Cap, limit.Duration, c)
+	if allow {
+		return nil
+	}
+	if used == 0 {
+		log.Warnw("rate limit: check if redis is available, the call is allowed", "user", user, "method", method)
+		return nil
+	}
+
+	return &LimitedError{Scope: "account " + user, RetryAfter: delay}
+}
+
+// WrapFunctions wraps the methods of in into the func fields of out, which should be a pointer to
+// an Internal struct of the api proxy, as ratelimit.RateLimiter.WrapFunctions does.
+func (l *RedisLimiter) WrapFunctions(in interface{}, out interface{}) {
+	apiwrap.WrapInternal(in, out, func(method string, fn reflect.Value, args []reflect.Value) []reflect.Value {
+		if err := l.Allow(args[0].Interface().(context.Context), method); err != nil {
+			log.Debugw("call limited", "method", method, "err", err)
+			return apiwrap.ErrorResults(fn.Type(), err)
+		}
+
+		return fn.Call(args)
+	})
+}
@@ -0,0 +1,76 @@
+package limiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/ipfs-force-community/metrics/ratelimit"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ipfs-force-community/sophon-co/cost"
+)
+
+type mockLimitFinder map[string]*ratelimit.Limit
+
+func (m mockLimitFinder) GetUserLimit(user, _, _ string) (*ratelimit.Limit, error) {
+	limit, ok := m[user]
+	if !ok {
+		return nil, fmt.Errorf("user %s not found", user)
+	}
+	return limit, nil
+}
+
+// memCounter counts the events as redis does, in a single period
+type memCounter map[string]int64
+
+func (m memCounter) allowN(name string, maxn int64, _ time.Duration, n int64) (int64, time.Duration, bool) {
+	m[name] += n
+	return m[name], time.Minute, m[name] <= maxn
+}
+
+func TestRedisLimiter(t *testing.T) {
+	counter := memCounter{}
+	l := &RedisLimiter{
+		finder: mockLimitFinder{
+			"alice": {Account: "alice", Cap: 10, Duration: time.Minute},
+			"bob":   {Account: "bob", Duration: time.Minute},
+		},
+		costs:  cost.New(map[string]int{"ChainHead": 4, "Version": 100}),
+		allowN: counter.allowN,
+	}
+
+	var full api.FullNodeStruct
+	l.WrapFunctions(&mockFullNode{}, &full.Internal)
+	l.WrapFunctions(&mockFullNode{}, &full.CommonStruct.Internal)
+
+	// each call is charged its cost
+	for i := 0; i < 2; i++ {
+		_, err := full.ChainHead(userCtx("alice"))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int64(8), counter["alice"])
+
+	_, err := full.ChainHead(userCtx("alice"))
+	var limited *LimitedError
+	assert.True(t, errors.As(err, &limited))
+	assert.Equal(t, "account alice", limited.Scope)
+	assert.Equal(t, time.Minute, limited.RetryAfter)
+	assert.True(t, errors.Is(err, ErrCodeRateLimited))
+
+	// the cost is capped by the limit
+	delete(counter, "alice")
+	_, err = full.Version(userCtx("alice"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), counter["alice"])
+
+	// the calls are allowed without a limit or a known account
+	for _, ctx := range []context.Context{userCtx("bob"), userCtx("carol"), context.Background()} {
+		_, err = full.ChainHead(ctx)
+		assert.NoError(t, err)
+	}
+	assert.NotContains(t, counter, "bob")
+}
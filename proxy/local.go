@@ -2,11 +2,20 @@ package proxy
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
 	api1 "github.com/filecoin-project/lotus/api"
+	apitypes "github.com/filecoin-project/lotus/api/types"
 	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/google/uuid"
 	"github.com/ipfs-force-community/sophon-co/api"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/peer"
 )
 
 var _ LocalAPI = (*Local)(nil)
@@ -16,12 +25,12 @@ type LocalAPI interface {
 }
 
 type Local struct {
-	Select func(types.TipSetKey) (LocalAPI, error)
+	Select func(context.Context, types.TipSetKey) (LocalAPI, error)
 }
 
 // impl api.Local
 func (p *Local) ChainHead(in0 context.Context) (out0 *types.TipSet, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainHead %v", err)
 		return
@@ -30,10 +39,172 @@ func (p *Local) ChainHead(in0 context.Context) (out0 *types.TipSet, err error) {
 }
 
 func (p *Local) ChainNotify(in0 context.Context) (out0 <-chan []*api1.HeadChange, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainNotify %v", err)
 		return
 	}
 	return cli.ChainNotify(in0)
 }
+
+func (p *Local) Closing(in0 context.Context) (out0 <-chan struct{}, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api Closing %v", err)
+		return
+	}
+	return cli.Closing(in0)
+}
+
+func (p *Local) Discover(in0 context.Context) (out0 apitypes.OpenRPCDocument, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api Discover %v", err)
+		return
+	}
+	return cli.Discover(in0)
+}
+
+func (p *Local) GasBatchEstimateMessageGas(in0 context.Context, in1 []*api1.EstimateMessage, in2 uint64, in3 types.TipSetKey) (out0 []*api1.EstimateResult, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api GasBatchEstimateMessageGas %v", err)
+		return
+	}
+	return cli.GasBatchEstimateMessageGas(in0, in1, in2, in3)
+}
+
+func (p *Local) GasEstimateFeeCap(in0 context.Context, in1 *types.Message, in2 int64, in3 types.TipSetKey) (out0 big.Int, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api GasEstimateFeeCap %v", err)
+		return
+	}
+	return cli.GasEstimateFeeCap(in0, in1, in2, in3)
+}
+
+func (p *Local) GasEstimateGasPremium(in0 context.Context, in1 uint64, in2 address.Address, in3 int64, in4 types.TipSetKey) (out0 big.Int, err error) {
+	cli, err := p.Select(in0, in4)
+	if err != nil {
+		err = fmt.Errorf("api GasEstimateGasPremium %v", err)
+		return
+	}
+	return cli.GasEstimateGasPremium(in0, in1, in2, in3, in4)
+}
+
+func (p *Local) GasEstimateMessageGas(in0 context.Context, in1 *types.Message, in2 *api1.MessageSendSpec, in3 types.TipSetKey) (out0 *types.Message, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api GasEstimateMessageGas %v", err)
+		return
+	}
+	return cli.GasEstimateMessageGas(in0, in1, in2, in3)
+}
+
+func (p *Local) ID(in0 context.Context) (out0 peer.ID, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ID %v", err)
+		return
+	}
+	return cli.ID(in0)
+}
+
+func (p *Local) LogList(in0 context.Context) (out0 []string, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api LogList %v", err)
+		return
+	}
+	return cli.LogList(in0)
+}
+
+func (p *Local) LogSetLevel(in0 context.Context, in1 string, in2 string) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api LogSetLevel %v", err)
+		return
+	}
+	return cli.LogSetLevel(in0, in1, in2)
+}
+
+func (p *Local) Session(in0 context.Context) (out0 uuid.UUID, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api Session %v", err)
+		return
+	}
+	return cli.Session(in0)
+}
+
+func (p *Local) StartTime(in0 context.Context) (out0 time.Time, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api StartTime %v", err)
+		return
+	}
+	return cli.StartTime(in0)
+}
+
+func (p *Local) StateDecodeParams(in0 context.Context, in1 address.Address, in2 abi.MethodNum, in3 []uint8, in4 types.TipSetKey) (out0 interface{}, err error) {
+	cli, err := p.Select(in0, in4)
+	if err != nil {
+		err = fmt.Errorf("api StateDecodeParams %v", err)
+		return
+	}
+	return cli.StateDecodeParams(in0, in1, in2, in3, in4)
+}
+
+func (p *Local) StateEncodeParams(in0 context.Context, in1 cid.Cid, in2 abi.MethodNum, in3 json.RawMessage) (out0 []uint8, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api StateEncodeParams %v", err)
+		return
+	}
+	return cli.StateEncodeParams(in0, in1, in2, in3)
+}
+
+func (p *Local) StateSearchMsg(in0 context.Context, in1 types.TipSetKey, in2 cid.Cid, in3 abi.ChainEpoch, in4 bool) (out0 *api1.MsgLookup, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api StateSearchMsg %v", err)
+		return
+	}
+	return cli.StateSearchMsg(in0, in1, in2, in3, in4)
+}
+
+func (p *Local) StateSearchMsgLimited(in0 context.Context, in1 cid.Cid, in2 abi.ChainEpoch) (out0 *api1.MsgLookup, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api StateSearchMsgLimited %v", err)
+		return
+	}
+	return cli.StateSearchMsgLimited(in0, in1, in2)
+}
+
+func (p *Local) StateWaitMsg(in0 context.Context, in1 cid.Cid, in2 uint64, in3 abi.ChainEpoch, in4 bool) (out0 *api1.MsgLookup, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api StateWaitMsg %v", err)
+		return
+	}
+	return cli.StateWaitMsg(in0, in1, in2, in3, in4)
+}
+
+func (p *Local) StateWaitMsgLimited(in0 context.Context, in1 cid.Cid, in2 uint64, in3 abi.ChainEpoch) (out0 *api1.MsgLookup, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api StateWaitMsgLimited %v", err)
+		return
+	}
+	return cli.StateWaitMsgLimited(in0, in1, in2, in3)
+}
+
+func (p *Local) WalletValidateAddress(in0 context.Context, in1 string) (out0 address.Address, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api WalletValidateAddress %v", err)
+		return
+	}
+	return cli.WalletValidateAddress(in0, in1)
+}
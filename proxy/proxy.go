@@ -13,6 +13,7 @@ import (
 	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/filecoin-project/go-state-types/big"
 	"github.com/filecoin-project/go-state-types/builtin/v17/miner"
+	"github.com/filecoin-project/go-state-types/builtin/v8/paych"
 	miner1 "github.com/filecoin-project/go-state-types/builtin/v9/miner"
 	"github.com/filecoin-project/go-state-types/builtin/v9/verifreg"
 	"github.com/filecoin-project/go-state-types/crypto"
@@ -35,12 +36,30 @@ type ProxyAPI interface {
 }
 
 type Proxy struct {
-	Select func(types.TipSetKey) (ProxyAPI, error)
+	Select func(context.Context, types.TipSetKey) (ProxyAPI, error)
 }
 
 // impl api.Proxy
+func (p *Proxy) ChainBlockstoreInfo(in0 context.Context) (out0 map[string]interface{}, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainBlockstoreInfo %v", err)
+		return
+	}
+	return cli.ChainBlockstoreInfo(in0)
+}
+
+func (p *Proxy) ChainExport(in0 context.Context, in1 abi.ChainEpoch, in2 bool, in3 types.TipSetKey) (out0 <-chan []uint8, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api ChainExport %v", err)
+		return
+	}
+	return cli.ChainExport(in0, in1, in2, in3)
+}
+
 func (p *Proxy) ChainGetBlock(in0 context.Context, in1 cid.Cid) (out0 *types.BlockHeader, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainGetBlock %v", err)
 		return
@@ -49,7 +68,7 @@ func (p *Proxy) ChainGetBlock(in0 context.Context, in1 cid.Cid) (out0 *types.Blo
 }
 
 func (p *Proxy) ChainGetBlockMessages(in0 context.Context, in1 cid.Cid) (out0 *api1.BlockMessages, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainGetBlockMessages %v", err)
 		return
@@ -58,7 +77,7 @@ func (p *Proxy) ChainGetBlockMessages(in0 context.Context, in1 cid.Cid) (out0 *a
 }
 
 func (p *Proxy) ChainGetEvents(in0 context.Context, in1 cid.Cid) (out0 []types.Event, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainGetEvents %v", err)
 		return
@@ -67,7 +86,7 @@ func (p *Proxy) ChainGetEvents(in0 context.Context, in1 cid.Cid) (out0 []types.E
 }
 
 func (p *Proxy) ChainGetFinalizedTipSet(in0 context.Context) (out0 *types.TipSet, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainGetFinalizedTipSet %v", err)
 		return
@@ -76,7 +95,7 @@ func (p *Proxy) ChainGetFinalizedTipSet(in0 context.Context) (out0 *types.TipSet
 }
 
 func (p *Proxy) ChainGetGenesis(in0 context.Context) (out0 *types.TipSet, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainGetGenesis %v", err)
 		return
@@ -85,7 +104,7 @@ func (p *Proxy) ChainGetGenesis(in0 context.Context) (out0 *types.TipSet, err er
 }
 
 func (p *Proxy) ChainGetMessage(in0 context.Context, in1 cid.Cid) (out0 *types.Message, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainGetMessage %v", err)
 		return
@@ -94,7 +113,7 @@ func (p *Proxy) ChainGetMessage(in0 context.Context, in1 cid.Cid) (out0 *types.M
 }
 
 func (p *Proxy) ChainGetMessagesInTipset(in0 context.Context, in1 types.TipSetKey) (out0 []api1.Message, err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api ChainGetMessagesInTipset %v", err)
 		return
@@ -102,8 +121,17 @@ func (p *Proxy) ChainGetMessagesInTipset(in0 context.Context, in1 types.TipSetKe
 	return cli.ChainGetMessagesInTipset(in0, in1)
 }
 
+func (p *Proxy) ChainGetNode(in0 context.Context, in1 string) (out0 *api1.IpldObject, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainGetNode %v", err)
+		return
+	}
+	return cli.ChainGetNode(in0, in1)
+}
+
 func (p *Proxy) ChainGetParentMessages(in0 context.Context, in1 cid.Cid) (out0 []api1.Message, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainGetParentMessages %v", err)
 		return
@@ -112,7 +140,7 @@ func (p *Proxy) ChainGetParentMessages(in0 context.Context, in1 cid.Cid) (out0 [
 }
 
 func (p *Proxy) ChainGetParentReceipts(in0 context.Context, in1 cid.Cid) (out0 []*types.MessageReceipt, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainGetParentReceipts %v", err)
 		return
@@ -121,7 +149,7 @@ func (p *Proxy) ChainGetParentReceipts(in0 context.Context, in1 cid.Cid) (out0 [
 }
 
 func (p *Proxy) ChainGetPath(in0 context.Context, in1 types.TipSetKey, in2 types.TipSetKey) (out0 []*api1.HeadChange, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api ChainGetPath %v", err)
 		return
@@ -130,7 +158,7 @@ func (p *Proxy) ChainGetPath(in0 context.Context, in1 types.TipSetKey, in2 types
 }
 
 func (p *Proxy) ChainGetTipSet(in0 context.Context, in1 types.TipSetKey) (out0 *types.TipSet, err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api ChainGetTipSet %v", err)
 		return
@@ -139,7 +167,7 @@ func (p *Proxy) ChainGetTipSet(in0 context.Context, in1 types.TipSetKey) (out0 *
 }
 
 func (p *Proxy) ChainGetTipSetAfterHeight(in0 context.Context, in1 abi.ChainEpoch, in2 types.TipSetKey) (out0 *types.TipSet, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api ChainGetTipSetAfterHeight %v", err)
 		return
@@ -148,7 +176,7 @@ func (p *Proxy) ChainGetTipSetAfterHeight(in0 context.Context, in1 abi.ChainEpoc
 }
 
 func (p *Proxy) ChainGetTipSetByHeight(in0 context.Context, in1 abi.ChainEpoch, in2 types.TipSetKey) (out0 *types.TipSet, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api ChainGetTipSetByHeight %v", err)
 		return
@@ -157,7 +185,7 @@ func (p *Proxy) ChainGetTipSetByHeight(in0 context.Context, in1 abi.ChainEpoch,
 }
 
 func (p *Proxy) ChainHasObj(in0 context.Context, in1 cid.Cid) (out0 bool, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainHasObj %v", err)
 		return
@@ -166,7 +194,7 @@ func (p *Proxy) ChainHasObj(in0 context.Context, in1 cid.Cid) (out0 bool, err er
 }
 
 func (p *Proxy) ChainReadObj(in0 context.Context, in1 cid.Cid) (out0 []uint8, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainReadObj %v", err)
 		return
@@ -175,7 +203,7 @@ func (p *Proxy) ChainReadObj(in0 context.Context, in1 cid.Cid) (out0 []uint8, er
 }
 
 func (p *Proxy) ChainStatObj(in0 context.Context, in1 cid.Cid, in2 cid.Cid) (out0 api1.ObjStat, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainStatObj %v", err)
 		return
@@ -184,7 +212,7 @@ func (p *Proxy) ChainStatObj(in0 context.Context, in1 cid.Cid, in2 cid.Cid) (out
 }
 
 func (p *Proxy) ChainTipSetWeight(in0 context.Context, in1 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api ChainTipSetWeight %v", err)
 		return
@@ -193,7 +221,7 @@ func (p *Proxy) ChainTipSetWeight(in0 context.Context, in1 types.TipSetKey) (out
 }
 
 func (p *Proxy) ChainValidateIndex(in0 context.Context, in1 abi.ChainEpoch, in2 bool) (out0 *types.IndexValidation, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainValidateIndex %v", err)
 		return
@@ -202,7 +230,7 @@ func (p *Proxy) ChainValidateIndex(in0 context.Context, in1 abi.ChainEpoch, in2
 }
 
 func (p *Proxy) EthAccounts(in0 context.Context) (out0 []ethtypes.EthAddress, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthAccounts %v", err)
 		return
@@ -211,7 +239,7 @@ func (p *Proxy) EthAccounts(in0 context.Context) (out0 []ethtypes.EthAddress, er
 }
 
 func (p *Proxy) EthAddressToFilecoinAddress(in0 context.Context, in1 ethtypes.EthAddress) (out0 address.Address, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthAddressToFilecoinAddress %v", err)
 		return
@@ -220,7 +248,7 @@ func (p *Proxy) EthAddressToFilecoinAddress(in0 context.Context, in1 ethtypes.Et
 }
 
 func (p *Proxy) EthBlockNumber(in0 context.Context) (out0 ethtypes.EthUint64, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthBlockNumber %v", err)
 		return
@@ -229,7 +257,7 @@ func (p *Proxy) EthBlockNumber(in0 context.Context) (out0 ethtypes.EthUint64, er
 }
 
 func (p *Proxy) EthCall(in0 context.Context, in1 ethtypes.EthCall, in2 ethtypes.EthBlockNumberOrHash) (out0 ethtypes.EthBytes, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthCall %v", err)
 		return
@@ -238,7 +266,7 @@ func (p *Proxy) EthCall(in0 context.Context, in1 ethtypes.EthCall, in2 ethtypes.
 }
 
 func (p *Proxy) EthChainId(in0 context.Context) (out0 ethtypes.EthUint64, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthChainId %v", err)
 		return
@@ -247,7 +275,7 @@ func (p *Proxy) EthChainId(in0 context.Context) (out0 ethtypes.EthUint64, err er
 }
 
 func (p *Proxy) EthEstimateGas(in0 context.Context, in1 jsonrpc.RawParams) (out0 ethtypes.EthUint64, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthEstimateGas %v", err)
 		return
@@ -256,7 +284,7 @@ func (p *Proxy) EthEstimateGas(in0 context.Context, in1 jsonrpc.RawParams) (out0
 }
 
 func (p *Proxy) EthFeeHistory(in0 context.Context, in1 jsonrpc.RawParams) (out0 ethtypes.EthFeeHistory, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthFeeHistory %v", err)
 		return
@@ -265,7 +293,7 @@ func (p *Proxy) EthFeeHistory(in0 context.Context, in1 jsonrpc.RawParams) (out0
 }
 
 func (p *Proxy) EthGasPrice(in0 context.Context) (out0 ethtypes.EthBigInt, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGasPrice %v", err)
 		return
@@ -274,7 +302,7 @@ func (p *Proxy) EthGasPrice(in0 context.Context) (out0 ethtypes.EthBigInt, err e
 }
 
 func (p *Proxy) EthGetBalance(in0 context.Context, in1 ethtypes.EthAddress, in2 ethtypes.EthBlockNumberOrHash) (out0 ethtypes.EthBigInt, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetBalance %v", err)
 		return
@@ -283,7 +311,7 @@ func (p *Proxy) EthGetBalance(in0 context.Context, in1 ethtypes.EthAddress, in2
 }
 
 func (p *Proxy) EthGetBlockByHash(in0 context.Context, in1 ethtypes.EthHash, in2 bool) (out0 ethtypes.EthBlock, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetBlockByHash %v", err)
 		return
@@ -292,7 +320,7 @@ func (p *Proxy) EthGetBlockByHash(in0 context.Context, in1 ethtypes.EthHash, in2
 }
 
 func (p *Proxy) EthGetBlockByNumber(in0 context.Context, in1 string, in2 bool) (out0 ethtypes.EthBlock, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetBlockByNumber %v", err)
 		return
@@ -301,7 +329,7 @@ func (p *Proxy) EthGetBlockByNumber(in0 context.Context, in1 string, in2 bool) (
 }
 
 func (p *Proxy) EthGetBlockReceipts(in0 context.Context, in1 ethtypes.EthBlockNumberOrHash) (out0 []*ethtypes.EthTxReceipt, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetBlockReceipts %v", err)
 		return
@@ -310,7 +338,7 @@ func (p *Proxy) EthGetBlockReceipts(in0 context.Context, in1 ethtypes.EthBlockNu
 }
 
 func (p *Proxy) EthGetBlockReceiptsLimited(in0 context.Context, in1 ethtypes.EthBlockNumberOrHash, in2 abi.ChainEpoch) (out0 []*ethtypes.EthTxReceipt, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetBlockReceiptsLimited %v", err)
 		return
@@ -319,7 +347,7 @@ func (p *Proxy) EthGetBlockReceiptsLimited(in0 context.Context, in1 ethtypes.Eth
 }
 
 func (p *Proxy) EthGetBlockTransactionCountByHash(in0 context.Context, in1 ethtypes.EthHash) (out0 ethtypes.EthUint64, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetBlockTransactionCountByHash %v", err)
 		return
@@ -328,7 +356,7 @@ func (p *Proxy) EthGetBlockTransactionCountByHash(in0 context.Context, in1 ethty
 }
 
 func (p *Proxy) EthGetBlockTransactionCountByNumber(in0 context.Context, in1 string) (out0 ethtypes.EthUint64, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetBlockTransactionCountByNumber %v", err)
 		return
@@ -337,7 +365,7 @@ func (p *Proxy) EthGetBlockTransactionCountByNumber(in0 context.Context, in1 str
 }
 
 func (p *Proxy) EthGetCode(in0 context.Context, in1 ethtypes.EthAddress, in2 ethtypes.EthBlockNumberOrHash) (out0 ethtypes.EthBytes, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetCode %v", err)
 		return
@@ -346,7 +374,7 @@ func (p *Proxy) EthGetCode(in0 context.Context, in1 ethtypes.EthAddress, in2 eth
 }
 
 func (p *Proxy) EthGetFilterChanges(in0 context.Context, in1 ethtypes.EthFilterID) (out0 *ethtypes.EthFilterResult, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetFilterChanges %v", err)
 		return
@@ -355,7 +383,7 @@ func (p *Proxy) EthGetFilterChanges(in0 context.Context, in1 ethtypes.EthFilterI
 }
 
 func (p *Proxy) EthGetFilterLogs(in0 context.Context, in1 ethtypes.EthFilterID) (out0 *ethtypes.EthFilterResult, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetFilterLogs %v", err)
 		return
@@ -364,7 +392,7 @@ func (p *Proxy) EthGetFilterLogs(in0 context.Context, in1 ethtypes.EthFilterID)
 }
 
 func (p *Proxy) EthGetLogs(in0 context.Context, in1 *ethtypes.EthFilterSpec) (out0 *ethtypes.EthFilterResult, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetLogs %v", err)
 		return
@@ -373,7 +401,7 @@ func (p *Proxy) EthGetLogs(in0 context.Context, in1 *ethtypes.EthFilterSpec) (ou
 }
 
 func (p *Proxy) EthGetMessageCidByTransactionHash(in0 context.Context, in1 *ethtypes.EthHash) (out0 *cid.Cid, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetMessageCidByTransactionHash %v", err)
 		return
@@ -382,7 +410,7 @@ func (p *Proxy) EthGetMessageCidByTransactionHash(in0 context.Context, in1 *etht
 }
 
 func (p *Proxy) EthGetStorageAt(in0 context.Context, in1 ethtypes.EthAddress, in2 ethtypes.EthBytes, in3 ethtypes.EthBlockNumberOrHash) (out0 ethtypes.EthBytes, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetStorageAt %v", err)
 		return
@@ -391,7 +419,7 @@ func (p *Proxy) EthGetStorageAt(in0 context.Context, in1 ethtypes.EthAddress, in
 }
 
 func (p *Proxy) EthGetTransactionByBlockHashAndIndex(in0 context.Context, in1 ethtypes.EthHash, in2 ethtypes.EthUint64) (out0 *ethtypes.EthTx, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetTransactionByBlockHashAndIndex %v", err)
 		return
@@ -400,7 +428,7 @@ func (p *Proxy) EthGetTransactionByBlockHashAndIndex(in0 context.Context, in1 et
 }
 
 func (p *Proxy) EthGetTransactionByBlockNumberAndIndex(in0 context.Context, in1 string, in2 ethtypes.EthUint64) (out0 *ethtypes.EthTx, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetTransactionByBlockNumberAndIndex %v", err)
 		return
@@ -409,7 +437,7 @@ func (p *Proxy) EthGetTransactionByBlockNumberAndIndex(in0 context.Context, in1
 }
 
 func (p *Proxy) EthGetTransactionByHash(in0 context.Context, in1 *ethtypes.EthHash) (out0 *ethtypes.EthTx, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetTransactionByHash %v", err)
 		return
@@ -418,7 +446,7 @@ func (p *Proxy) EthGetTransactionByHash(in0 context.Context, in1 *ethtypes.EthHa
 }
 
 func (p *Proxy) EthGetTransactionByHashLimited(in0 context.Context, in1 *ethtypes.EthHash, in2 abi.ChainEpoch) (out0 *ethtypes.EthTx, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetTransactionByHashLimited %v", err)
 		return
@@ -427,7 +455,7 @@ func (p *Proxy) EthGetTransactionByHashLimited(in0 context.Context, in1 *ethtype
 }
 
 func (p *Proxy) EthGetTransactionCount(in0 context.Context, in1 ethtypes.EthAddress, in2 ethtypes.EthBlockNumberOrHash) (out0 ethtypes.EthUint64, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetTransactionCount %v", err)
 		return
@@ -436,7 +464,7 @@ func (p *Proxy) EthGetTransactionCount(in0 context.Context, in1 ethtypes.EthAddr
 }
 
 func (p *Proxy) EthGetTransactionHashByCid(in0 context.Context, in1 cid.Cid) (out0 *ethtypes.EthHash, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetTransactionHashByCid %v", err)
 		return
@@ -445,7 +473,7 @@ func (p *Proxy) EthGetTransactionHashByCid(in0 context.Context, in1 cid.Cid) (ou
 }
 
 func (p *Proxy) EthGetTransactionReceipt(in0 context.Context, in1 ethtypes.EthHash) (out0 *ethtypes.EthTxReceipt, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetTransactionReceipt %v", err)
 		return
@@ -454,7 +482,7 @@ func (p *Proxy) EthGetTransactionReceipt(in0 context.Context, in1 ethtypes.EthHa
 }
 
 func (p *Proxy) EthGetTransactionReceiptLimited(in0 context.Context, in1 ethtypes.EthHash, in2 abi.ChainEpoch) (out0 *ethtypes.EthTxReceipt, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthGetTransactionReceiptLimited %v", err)
 		return
@@ -463,7 +491,7 @@ func (p *Proxy) EthGetTransactionReceiptLimited(in0 context.Context, in1 ethtype
 }
 
 func (p *Proxy) EthMaxPriorityFeePerGas(in0 context.Context) (out0 ethtypes.EthBigInt, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthMaxPriorityFeePerGas %v", err)
 		return
@@ -472,7 +500,7 @@ func (p *Proxy) EthMaxPriorityFeePerGas(in0 context.Context) (out0 ethtypes.EthB
 }
 
 func (p *Proxy) EthNewBlockFilter(in0 context.Context) (out0 ethtypes.EthFilterID, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthNewBlockFilter %v", err)
 		return
@@ -481,7 +509,7 @@ func (p *Proxy) EthNewBlockFilter(in0 context.Context) (out0 ethtypes.EthFilterI
 }
 
 func (p *Proxy) EthNewFilter(in0 context.Context, in1 *ethtypes.EthFilterSpec) (out0 ethtypes.EthFilterID, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthNewFilter %v", err)
 		return
@@ -490,7 +518,7 @@ func (p *Proxy) EthNewFilter(in0 context.Context, in1 *ethtypes.EthFilterSpec) (
 }
 
 func (p *Proxy) EthNewPendingTransactionFilter(in0 context.Context) (out0 ethtypes.EthFilterID, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthNewPendingTransactionFilter %v", err)
 		return
@@ -499,7 +527,7 @@ func (p *Proxy) EthNewPendingTransactionFilter(in0 context.Context) (out0 ethtyp
 }
 
 func (p *Proxy) EthProtocolVersion(in0 context.Context) (out0 ethtypes.EthUint64, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthProtocolVersion %v", err)
 		return
@@ -508,7 +536,7 @@ func (p *Proxy) EthProtocolVersion(in0 context.Context) (out0 ethtypes.EthUint64
 }
 
 func (p *Proxy) EthSendRawTransaction(in0 context.Context, in1 ethtypes.EthBytes) (out0 ethtypes.EthHash, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthSendRawTransaction %v", err)
 		return
@@ -517,7 +545,7 @@ func (p *Proxy) EthSendRawTransaction(in0 context.Context, in1 ethtypes.EthBytes
 }
 
 func (p *Proxy) EthSendRawTransactionUntrusted(in0 context.Context, in1 ethtypes.EthBytes) (out0 ethtypes.EthHash, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthSendRawTransactionUntrusted %v", err)
 		return
@@ -526,7 +554,7 @@ func (p *Proxy) EthSendRawTransactionUntrusted(in0 context.Context, in1 ethtypes
 }
 
 func (p *Proxy) EthSubscribe(in0 context.Context, in1 jsonrpc.RawParams) (out0 ethtypes.EthSubscriptionID, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthSubscribe %v", err)
 		return
@@ -535,7 +563,7 @@ func (p *Proxy) EthSubscribe(in0 context.Context, in1 jsonrpc.RawParams) (out0 e
 }
 
 func (p *Proxy) EthSyncing(in0 context.Context) (out0 ethtypes.EthSyncingResult, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthSyncing %v", err)
 		return
@@ -544,7 +572,7 @@ func (p *Proxy) EthSyncing(in0 context.Context) (out0 ethtypes.EthSyncingResult,
 }
 
 func (p *Proxy) EthTraceBlock(in0 context.Context, in1 string) (out0 []*ethtypes.EthTraceBlock, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthTraceBlock %v", err)
 		return
@@ -553,7 +581,7 @@ func (p *Proxy) EthTraceBlock(in0 context.Context, in1 string) (out0 []*ethtypes
 }
 
 func (p *Proxy) EthTraceFilter(in0 context.Context, in1 ethtypes.EthTraceFilterCriteria) (out0 []*ethtypes.EthTraceFilterResult, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthTraceFilter %v", err)
 		return
@@ -562,7 +590,7 @@ func (p *Proxy) EthTraceFilter(in0 context.Context, in1 ethtypes.EthTraceFilterC
 }
 
 func (p *Proxy) EthTraceReplayBlockTransactions(in0 context.Context, in1 string, in2 []string) (out0 []*ethtypes.EthTraceReplayBlockTransaction, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthTraceReplayBlockTransactions %v", err)
 		return
@@ -571,7 +599,7 @@ func (p *Proxy) EthTraceReplayBlockTransactions(in0 context.Context, in1 string,
 }
 
 func (p *Proxy) EthTraceTransaction(in0 context.Context, in1 string) (out0 []*ethtypes.EthTraceTransaction, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthTraceTransaction %v", err)
 		return
@@ -580,7 +608,7 @@ func (p *Proxy) EthTraceTransaction(in0 context.Context, in1 string) (out0 []*et
 }
 
 func (p *Proxy) EthUninstallFilter(in0 context.Context, in1 ethtypes.EthFilterID) (out0 bool, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthUninstallFilter %v", err)
 		return
@@ -589,7 +617,7 @@ func (p *Proxy) EthUninstallFilter(in0 context.Context, in1 ethtypes.EthFilterID
 }
 
 func (p *Proxy) EthUnsubscribe(in0 context.Context, in1 ethtypes.EthSubscriptionID) (out0 bool, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api EthUnsubscribe %v", err)
 		return
@@ -598,7 +626,7 @@ func (p *Proxy) EthUnsubscribe(in0 context.Context, in1 ethtypes.EthSubscription
 }
 
 func (p *Proxy) F3GetCertificate(in0 context.Context, in1 uint64) (out0 *certs.FinalityCertificate, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api F3GetCertificate %v", err)
 		return
@@ -607,7 +635,7 @@ func (p *Proxy) F3GetCertificate(in0 context.Context, in1 uint64) (out0 *certs.F
 }
 
 func (p *Proxy) F3GetECPowerTable(in0 context.Context, in1 types.TipSetKey) (out0 gpbft.PowerEntries, err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api F3GetECPowerTable %v", err)
 		return
@@ -616,7 +644,7 @@ func (p *Proxy) F3GetECPowerTable(in0 context.Context, in1 types.TipSetKey) (out
 }
 
 func (p *Proxy) F3GetF3PowerTable(in0 context.Context, in1 types.TipSetKey) (out0 gpbft.PowerEntries, err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api F3GetF3PowerTable %v", err)
 		return
@@ -625,7 +653,7 @@ func (p *Proxy) F3GetF3PowerTable(in0 context.Context, in1 types.TipSetKey) (out
 }
 
 func (p *Proxy) F3GetLatestCertificate(in0 context.Context) (out0 *certs.FinalityCertificate, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api F3GetLatestCertificate %v", err)
 		return
@@ -634,7 +662,7 @@ func (p *Proxy) F3GetLatestCertificate(in0 context.Context) (out0 *certs.Finalit
 }
 
 func (p *Proxy) F3GetManifest(in0 context.Context) (out0 *manifest.Manifest, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api F3GetManifest %v", err)
 		return
@@ -643,7 +671,7 @@ func (p *Proxy) F3GetManifest(in0 context.Context) (out0 *manifest.Manifest, err
 }
 
 func (p *Proxy) F3GetOrRenewParticipationTicket(in0 context.Context, in1 address.Address, in2 api1.F3ParticipationTicket, in3 uint64) (out0 api1.F3ParticipationTicket, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api F3GetOrRenewParticipationTicket %v", err)
 		return
@@ -652,7 +680,7 @@ func (p *Proxy) F3GetOrRenewParticipationTicket(in0 context.Context, in1 address
 }
 
 func (p *Proxy) F3GetPowerTableByInstance(in0 context.Context, in1 uint64) (out0 gpbft.PowerEntries, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api F3GetPowerTableByInstance %v", err)
 		return
@@ -661,7 +689,7 @@ func (p *Proxy) F3GetPowerTableByInstance(in0 context.Context, in1 uint64) (out0
 }
 
 func (p *Proxy) F3GetProgress(in0 context.Context) (out0 gpbft.InstanceProgress, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api F3GetProgress %v", err)
 		return
@@ -670,7 +698,7 @@ func (p *Proxy) F3GetProgress(in0 context.Context) (out0 gpbft.InstanceProgress,
 }
 
 func (p *Proxy) F3IsRunning(in0 context.Context) (out0 bool, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api F3IsRunning %v", err)
 		return
@@ -679,7 +707,7 @@ func (p *Proxy) F3IsRunning(in0 context.Context) (out0 bool, err error) {
 }
 
 func (p *Proxy) F3ListParticipants(in0 context.Context) (out0 []api1.F3Participant, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api F3ListParticipants %v", err)
 		return
@@ -688,7 +716,7 @@ func (p *Proxy) F3ListParticipants(in0 context.Context) (out0 []api1.F3Participa
 }
 
 func (p *Proxy) F3Participate(in0 context.Context, in1 api1.F3ParticipationTicket) (out0 api1.F3ParticipationLease, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api F3Participate %v", err)
 		return
@@ -697,7 +725,7 @@ func (p *Proxy) F3Participate(in0 context.Context, in1 api1.F3ParticipationTicke
 }
 
 func (p *Proxy) FilecoinAddressToEthAddress(in0 context.Context, in1 jsonrpc.RawParams) (out0 ethtypes.EthAddress, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api FilecoinAddressToEthAddress %v", err)
 		return
@@ -705,26 +733,8 @@ func (p *Proxy) FilecoinAddressToEthAddress(in0 context.Context, in1 jsonrpc.Raw
 	return cli.FilecoinAddressToEthAddress(in0, in1)
 }
 
-func (p *Proxy) GasBatchEstimateMessageGas(in0 context.Context, in1 []*api1.EstimateMessage, in2 uint64, in3 types.TipSetKey) (out0 []*api1.EstimateResult, err error) {
-	cli, err := p.Select(in3)
-	if err != nil {
-		err = fmt.Errorf("api GasBatchEstimateMessageGas %v", err)
-		return
-	}
-	return cli.GasBatchEstimateMessageGas(in0, in1, in2, in3)
-}
-
-func (p *Proxy) GasEstimateFeeCap(in0 context.Context, in1 *types.Message, in2 int64, in3 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select(in3)
-	if err != nil {
-		err = fmt.Errorf("api GasEstimateFeeCap %v", err)
-		return
-	}
-	return cli.GasEstimateFeeCap(in0, in1, in2, in3)
-}
-
 func (p *Proxy) GasEstimateGasLimit(in0 context.Context, in1 *types.Message, in2 types.TipSetKey) (out0 int64, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api GasEstimateGasLimit %v", err)
 		return
@@ -732,26 +742,8 @@ func (p *Proxy) GasEstimateGasLimit(in0 context.Context, in1 *types.Message, in2
 	return cli.GasEstimateGasLimit(in0, in1, in2)
 }
 
-func (p *Proxy) GasEstimateGasPremium(in0 context.Context, in1 uint64, in2 address.Address, in3 int64, in4 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select(in4)
-	if err != nil {
-		err = fmt.Errorf("api GasEstimateGasPremium %v", err)
-		return
-	}
-	return cli.GasEstimateGasPremium(in0, in1, in2, in3, in4)
-}
-
-func (p *Proxy) GasEstimateMessageGas(in0 context.Context, in1 *types.Message, in2 *api1.MessageSendSpec, in3 types.TipSetKey) (out0 *types.Message, err error) {
-	cli, err := p.Select(in3)
-	if err != nil {
-		err = fmt.Errorf("api GasEstimateMessageGas %v", err)
-		return
-	}
-	return cli.GasEstimateMessageGas(in0, in1, in2, in3)
-}
-
 func (p *Proxy) GetActorEventsRaw(in0 context.Context, in1 *types.ActorEventFilter) (out0 []*types.ActorEvent, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api GetActorEventsRaw %v", err)
 		return
@@ -760,7 +752,7 @@ func (p *Proxy) GetActorEventsRaw(in0 context.Context, in1 *types.ActorEventFilt
 }
 
 func (p *Proxy) MinerCreateBlock(in0 context.Context, in1 *api1.BlockTemplate) (out0 *types.BlockMsg, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MinerCreateBlock %v", err)
 		return
@@ -769,7 +761,7 @@ func (p *Proxy) MinerCreateBlock(in0 context.Context, in1 *api1.BlockTemplate) (
 }
 
 func (p *Proxy) MinerGetBaseInfo(in0 context.Context, in1 address.Address, in2 abi.ChainEpoch, in3 types.TipSetKey) (out0 *api1.MiningBaseInfo, err error) {
-	cli, err := p.Select(in3)
+	cli, err := p.Select(in0, in3)
 	if err != nil {
 		err = fmt.Errorf("api MinerGetBaseInfo %v", err)
 		return
@@ -778,7 +770,7 @@ func (p *Proxy) MinerGetBaseInfo(in0 context.Context, in1 address.Address, in2 a
 }
 
 func (p *Proxy) MpoolBatchPush(in0 context.Context, in1 []*types.SignedMessage) (out0 []cid.Cid, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MpoolBatchPush %v", err)
 		return
@@ -787,7 +779,7 @@ func (p *Proxy) MpoolBatchPush(in0 context.Context, in1 []*types.SignedMessage)
 }
 
 func (p *Proxy) MpoolBatchPushUntrusted(in0 context.Context, in1 []*types.SignedMessage) (out0 []cid.Cid, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MpoolBatchPushUntrusted %v", err)
 		return
@@ -795,8 +787,35 @@ func (p *Proxy) MpoolBatchPushUntrusted(in0 context.Context, in1 []*types.Signed
 	return cli.MpoolBatchPushUntrusted(in0, in1)
 }
 
+func (p *Proxy) MpoolCheckMessages(in0 context.Context, in1 []*api1.MessagePrototype) (out0 [][]api1.MessageCheckStatus, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolCheckMessages %v", err)
+		return
+	}
+	return cli.MpoolCheckMessages(in0, in1)
+}
+
+func (p *Proxy) MpoolCheckPendingMessages(in0 context.Context, in1 address.Address) (out0 [][]api1.MessageCheckStatus, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolCheckPendingMessages %v", err)
+		return
+	}
+	return cli.MpoolCheckPendingMessages(in0, in1)
+}
+
+func (p *Proxy) MpoolCheckReplaceMessages(in0 context.Context, in1 []*types.Message) (out0 [][]api1.MessageCheckStatus, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolCheckReplaceMessages %v", err)
+		return
+	}
+	return cli.MpoolCheckReplaceMessages(in0, in1)
+}
+
 func (p *Proxy) MpoolGetConfig(in0 context.Context) (out0 *types.MpoolConfig, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MpoolGetConfig %v", err)
 		return
@@ -805,7 +824,7 @@ func (p *Proxy) MpoolGetConfig(in0 context.Context) (out0 *types.MpoolConfig, er
 }
 
 func (p *Proxy) MpoolGetNonce(in0 context.Context, in1 address.Address) (out0 uint64, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MpoolGetNonce %v", err)
 		return
@@ -814,7 +833,7 @@ func (p *Proxy) MpoolGetNonce(in0 context.Context, in1 address.Address) (out0 ui
 }
 
 func (p *Proxy) MpoolPending(in0 context.Context, in1 types.TipSetKey) (out0 []*types.SignedMessage, err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api MpoolPending %v", err)
 		return
@@ -823,7 +842,7 @@ func (p *Proxy) MpoolPending(in0 context.Context, in1 types.TipSetKey) (out0 []*
 }
 
 func (p *Proxy) MpoolPublishByAddr(in0 context.Context, in1 address.Address) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MpoolPublishByAddr %v", err)
 		return
@@ -832,7 +851,7 @@ func (p *Proxy) MpoolPublishByAddr(in0 context.Context, in1 address.Address) (er
 }
 
 func (p *Proxy) MpoolPublishMessage(in0 context.Context, in1 *types.SignedMessage) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MpoolPublishMessage %v", err)
 		return
@@ -841,7 +860,7 @@ func (p *Proxy) MpoolPublishMessage(in0 context.Context, in1 *types.SignedMessag
 }
 
 func (p *Proxy) MpoolPush(in0 context.Context, in1 *types.SignedMessage) (out0 cid.Cid, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MpoolPush %v", err)
 		return
@@ -850,7 +869,7 @@ func (p *Proxy) MpoolPush(in0 context.Context, in1 *types.SignedMessage) (out0 c
 }
 
 func (p *Proxy) MpoolPushMessage(in0 context.Context, in1 *types.Message, in2 *api1.MessageSendSpec) (out0 *types.SignedMessage, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MpoolPushMessage %v", err)
 		return
@@ -859,7 +878,7 @@ func (p *Proxy) MpoolPushMessage(in0 context.Context, in1 *types.Message, in2 *a
 }
 
 func (p *Proxy) MpoolSelect(in0 context.Context, in1 types.TipSetKey, in2 float64) (out0 []*types.SignedMessage, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api MpoolSelect %v", err)
 		return
@@ -868,7 +887,7 @@ func (p *Proxy) MpoolSelect(in0 context.Context, in1 types.TipSetKey, in2 float6
 }
 
 func (p *Proxy) MpoolSelects(in0 context.Context, in1 types.TipSetKey, in2 []float64) (out0 [][]*types.SignedMessage, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api MpoolSelects %v", err)
 		return
@@ -876,8 +895,53 @@ func (p *Proxy) MpoolSelects(in0 context.Context, in1 types.TipSetKey, in2 []flo
 	return cli.MpoolSelects(in0, in1, in2)
 }
 
+func (p *Proxy) MpoolSub(in0 context.Context) (out0 <-chan api1.MpoolUpdate, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolSub %v", err)
+		return
+	}
+	return cli.MpoolSub(in0)
+}
+
+func (p *Proxy) MsigGetAvailableBalance(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 big.Int, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api MsigGetAvailableBalance %v", err)
+		return
+	}
+	return cli.MsigGetAvailableBalance(in0, in1, in2)
+}
+
+func (p *Proxy) MsigGetPending(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 []*api1.MsigTransaction, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api MsigGetPending %v", err)
+		return
+	}
+	return cli.MsigGetPending(in0, in1, in2)
+}
+
+func (p *Proxy) MsigGetVested(in0 context.Context, in1 address.Address, in2 types.TipSetKey, in3 types.TipSetKey) (out0 big.Int, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api MsigGetVested %v", err)
+		return
+	}
+	return cli.MsigGetVested(in0, in1, in2, in3)
+}
+
+func (p *Proxy) MsigGetVestingSchedule(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 api1.MsigVesting, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api MsigGetVestingSchedule %v", err)
+		return
+	}
+	return cli.MsigGetVestingSchedule(in0, in1, in2)
+}
+
 func (p *Proxy) NetAddrsListen(in0 context.Context) (out0 peer.AddrInfo, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetAddrsListen %v", err)
 		return
@@ -886,7 +950,7 @@ func (p *Proxy) NetAddrsListen(in0 context.Context) (out0 peer.AddrInfo, err err
 }
 
 func (p *Proxy) NetListening(in0 context.Context) (out0 bool, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetListening %v", err)
 		return
@@ -895,7 +959,7 @@ func (p *Proxy) NetListening(in0 context.Context) (out0 bool, err error) {
 }
 
 func (p *Proxy) NetProtectAdd(in0 context.Context, in1 []peer.ID) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetProtectAdd %v", err)
 		return
@@ -904,7 +968,7 @@ func (p *Proxy) NetProtectAdd(in0 context.Context, in1 []peer.ID) (err error) {
 }
 
 func (p *Proxy) NetVersion(in0 context.Context) (out0 string, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetVersion %v", err)
 		return
@@ -912,8 +976,53 @@ func (p *Proxy) NetVersion(in0 context.Context) (out0 string, err error) {
 	return cli.NetVersion(in0)
 }
 
+func (p *Proxy) NodeStatus(in0 context.Context, in1 bool) (out0 api1.NodeStatus, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NodeStatus %v", err)
+		return
+	}
+	return cli.NodeStatus(in0, in1)
+}
+
+func (p *Proxy) PaychList(in0 context.Context) (out0 []address.Address, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychList %v", err)
+		return
+	}
+	return cli.PaychList(in0)
+}
+
+func (p *Proxy) PaychStatus(in0 context.Context, in1 address.Address) (out0 *api1.PaychStatus, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychStatus %v", err)
+		return
+	}
+	return cli.PaychStatus(in0, in1)
+}
+
+func (p *Proxy) PaychVoucherCheckSpendable(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher, in3 []uint8, in4 []uint8) (out0 bool, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychVoucherCheckSpendable %v", err)
+		return
+	}
+	return cli.PaychVoucherCheckSpendable(in0, in1, in2, in3, in4)
+}
+
+func (p *Proxy) PaychVoucherCheckValid(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychVoucherCheckValid %v", err)
+		return
+	}
+	return cli.PaychVoucherCheckValid(in0, in1, in2)
+}
+
 func (p *Proxy) StateAccountKey(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 address.Address, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateAccountKey %v", err)
 		return
@@ -922,7 +1031,7 @@ func (p *Proxy) StateAccountKey(in0 context.Context, in1 address.Address, in2 ty
 }
 
 func (p *Proxy) StateActorCodeCIDs(in0 context.Context, in1 network.Version) (out0 map[string]cid.Cid, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api StateActorCodeCIDs %v", err)
 		return
@@ -931,7 +1040,7 @@ func (p *Proxy) StateActorCodeCIDs(in0 context.Context, in1 network.Version) (ou
 }
 
 func (p *Proxy) StateActorManifestCID(in0 context.Context, in1 network.Version) (out0 cid.Cid, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api StateActorManifestCID %v", err)
 		return
@@ -940,7 +1049,7 @@ func (p *Proxy) StateActorManifestCID(in0 context.Context, in1 network.Version)
 }
 
 func (p *Proxy) StateAllMinerFaults(in0 context.Context, in1 abi.ChainEpoch, in2 types.TipSetKey) (out0 []*api1.Fault, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateAllMinerFaults %v", err)
 		return
@@ -949,7 +1058,7 @@ func (p *Proxy) StateAllMinerFaults(in0 context.Context, in1 abi.ChainEpoch, in2
 }
 
 func (p *Proxy) StateCall(in0 context.Context, in1 *types.Message, in2 types.TipSetKey) (out0 *api1.InvocResult, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateCall %v", err)
 		return
@@ -958,7 +1067,7 @@ func (p *Proxy) StateCall(in0 context.Context, in1 *types.Message, in2 types.Tip
 }
 
 func (p *Proxy) StateChangedActors(in0 context.Context, in1 cid.Cid, in2 cid.Cid) (out0 map[string]types.ActorV5, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api StateChangedActors %v", err)
 		return
@@ -967,7 +1076,7 @@ func (p *Proxy) StateChangedActors(in0 context.Context, in1 cid.Cid, in2 cid.Cid
 }
 
 func (p *Proxy) StateCirculatingSupply(in0 context.Context, in1 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api StateCirculatingSupply %v", err)
 		return
@@ -975,8 +1084,17 @@ func (p *Proxy) StateCirculatingSupply(in0 context.Context, in1 types.TipSetKey)
 	return cli.StateCirculatingSupply(in0, in1)
 }
 
+func (p *Proxy) StateCompute(in0 context.Context, in1 abi.ChainEpoch, in2 []*types.Message, in3 types.TipSetKey) (out0 *api1.ComputeStateOutput, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api StateCompute %v", err)
+		return
+	}
+	return cli.StateCompute(in0, in1, in2, in3)
+}
+
 func (p *Proxy) StateComputeDataCID(in0 context.Context, in1 address.Address, in2 abi.RegisteredSealProof, in3 []abi.DealID, in4 types.TipSetKey) (out0 cid.Cid, err error) {
-	cli, err := p.Select(in4)
+	cli, err := p.Select(in0, in4)
 	if err != nil {
 		err = fmt.Errorf("api StateComputeDataCID %v", err)
 		return
@@ -985,7 +1103,7 @@ func (p *Proxy) StateComputeDataCID(in0 context.Context, in1 address.Address, in
 }
 
 func (p *Proxy) StateDealProviderCollateralBounds(in0 context.Context, in1 abi.PaddedPieceSize, in2 bool, in3 types.TipSetKey) (out0 api1.DealCollateralBounds, err error) {
-	cli, err := p.Select(in3)
+	cli, err := p.Select(in0, in3)
 	if err != nil {
 		err = fmt.Errorf("api StateDealProviderCollateralBounds %v", err)
 		return
@@ -994,7 +1112,7 @@ func (p *Proxy) StateDealProviderCollateralBounds(in0 context.Context, in1 abi.P
 }
 
 func (p *Proxy) StateGetActor(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *types.ActorV5, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateGetActor %v", err)
 		return
@@ -1003,7 +1121,7 @@ func (p *Proxy) StateGetActor(in0 context.Context, in1 address.Address, in2 type
 }
 
 func (p *Proxy) StateGetAllAllocations(in0 context.Context, in1 types.TipSetKey) (out0 map[verifreg.AllocationId]verifreg.Allocation, err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api StateGetAllAllocations %v", err)
 		return
@@ -1012,7 +1130,7 @@ func (p *Proxy) StateGetAllAllocations(in0 context.Context, in1 types.TipSetKey)
 }
 
 func (p *Proxy) StateGetAllClaims(in0 context.Context, in1 types.TipSetKey) (out0 map[verifreg.ClaimId]verifreg.Claim, err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api StateGetAllClaims %v", err)
 		return
@@ -1021,7 +1139,7 @@ func (p *Proxy) StateGetAllClaims(in0 context.Context, in1 types.TipSetKey) (out
 }
 
 func (p *Proxy) StateGetAllocation(in0 context.Context, in1 address.Address, in2 verifreg.AllocationId, in3 types.TipSetKey) (out0 *verifreg.Allocation, err error) {
-	cli, err := p.Select(in3)
+	cli, err := p.Select(in0, in3)
 	if err != nil {
 		err = fmt.Errorf("api StateGetAllocation %v", err)
 		return
@@ -1030,7 +1148,7 @@ func (p *Proxy) StateGetAllocation(in0 context.Context, in1 address.Address, in2
 }
 
 func (p *Proxy) StateGetAllocationForPendingDeal(in0 context.Context, in1 abi.DealID, in2 types.TipSetKey) (out0 *verifreg.Allocation, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateGetAllocationForPendingDeal %v", err)
 		return
@@ -1039,7 +1157,7 @@ func (p *Proxy) StateGetAllocationForPendingDeal(in0 context.Context, in1 abi.De
 }
 
 func (p *Proxy) StateGetAllocationIdForPendingDeal(in0 context.Context, in1 abi.DealID, in2 types.TipSetKey) (out0 verifreg.AllocationId, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateGetAllocationIdForPendingDeal %v", err)
 		return
@@ -1048,7 +1166,7 @@ func (p *Proxy) StateGetAllocationIdForPendingDeal(in0 context.Context, in1 abi.
 }
 
 func (p *Proxy) StateGetAllocations(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 map[verifreg.AllocationId]verifreg.Allocation, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateGetAllocations %v", err)
 		return
@@ -1057,7 +1175,7 @@ func (p *Proxy) StateGetAllocations(in0 context.Context, in1 address.Address, in
 }
 
 func (p *Proxy) StateGetBeaconEntry(in0 context.Context, in1 abi.ChainEpoch) (out0 *types.BeaconEntry, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api StateGetBeaconEntry %v", err)
 		return
@@ -1066,7 +1184,7 @@ func (p *Proxy) StateGetBeaconEntry(in0 context.Context, in1 abi.ChainEpoch) (ou
 }
 
 func (p *Proxy) StateGetClaim(in0 context.Context, in1 address.Address, in2 verifreg.ClaimId, in3 types.TipSetKey) (out0 *verifreg.Claim, err error) {
-	cli, err := p.Select(in3)
+	cli, err := p.Select(in0, in3)
 	if err != nil {
 		err = fmt.Errorf("api StateGetClaim %v", err)
 		return
@@ -1075,7 +1193,7 @@ func (p *Proxy) StateGetClaim(in0 context.Context, in1 address.Address, in2 veri
 }
 
 func (p *Proxy) StateGetClaims(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 map[verifreg.ClaimId]verifreg.Claim, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateGetClaims %v", err)
 		return
@@ -1084,7 +1202,7 @@ func (p *Proxy) StateGetClaims(in0 context.Context, in1 address.Address, in2 typ
 }
 
 func (p *Proxy) StateGetNetworkParams(in0 context.Context) (out0 *api1.NetworkParams, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api StateGetNetworkParams %v", err)
 		return
@@ -1093,7 +1211,7 @@ func (p *Proxy) StateGetNetworkParams(in0 context.Context) (out0 *api1.NetworkPa
 }
 
 func (p *Proxy) StateGetRandomnessDigestFromBeacon(in0 context.Context, in1 abi.ChainEpoch, in2 types.TipSetKey) (out0 abi.Randomness, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateGetRandomnessDigestFromBeacon %v", err)
 		return
@@ -1102,7 +1220,7 @@ func (p *Proxy) StateGetRandomnessDigestFromBeacon(in0 context.Context, in1 abi.
 }
 
 func (p *Proxy) StateGetRandomnessDigestFromTickets(in0 context.Context, in1 abi.ChainEpoch, in2 types.TipSetKey) (out0 abi.Randomness, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateGetRandomnessDigestFromTickets %v", err)
 		return
@@ -1111,7 +1229,7 @@ func (p *Proxy) StateGetRandomnessDigestFromTickets(in0 context.Context, in1 abi
 }
 
 func (p *Proxy) StateGetRandomnessFromBeacon(in0 context.Context, in1 crypto.DomainSeparationTag, in2 abi.ChainEpoch, in3 []uint8, in4 types.TipSetKey) (out0 abi.Randomness, err error) {
-	cli, err := p.Select(in4)
+	cli, err := p.Select(in0, in4)
 	if err != nil {
 		err = fmt.Errorf("api StateGetRandomnessFromBeacon %v", err)
 		return
@@ -1120,7 +1238,7 @@ func (p *Proxy) StateGetRandomnessFromBeacon(in0 context.Context, in1 crypto.Dom
 }
 
 func (p *Proxy) StateGetRandomnessFromTickets(in0 context.Context, in1 crypto.DomainSeparationTag, in2 abi.ChainEpoch, in3 []uint8, in4 types.TipSetKey) (out0 abi.Randomness, err error) {
-	cli, err := p.Select(in4)
+	cli, err := p.Select(in0, in4)
 	if err != nil {
 		err = fmt.Errorf("api StateGetRandomnessFromTickets %v", err)
 		return
@@ -1129,7 +1247,7 @@ func (p *Proxy) StateGetRandomnessFromTickets(in0 context.Context, in1 crypto.Do
 }
 
 func (p *Proxy) StateListActors(in0 context.Context, in1 types.TipSetKey) (out0 []address.Address, err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api StateListActors %v", err)
 		return
@@ -1137,8 +1255,17 @@ func (p *Proxy) StateListActors(in0 context.Context, in1 types.TipSetKey) (out0
 	return cli.StateListActors(in0, in1)
 }
 
+func (p *Proxy) StateListMessages(in0 context.Context, in1 *api1.MessageMatch, in2 types.TipSetKey, in3 abi.ChainEpoch) (out0 []cid.Cid, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateListMessages %v", err)
+		return
+	}
+	return cli.StateListMessages(in0, in1, in2, in3)
+}
+
 func (p *Proxy) StateListMiners(in0 context.Context, in1 types.TipSetKey) (out0 []address.Address, err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api StateListMiners %v", err)
 		return
@@ -1147,7 +1274,7 @@ func (p *Proxy) StateListMiners(in0 context.Context, in1 types.TipSetKey) (out0
 }
 
 func (p *Proxy) StateLookupID(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 address.Address, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateLookupID %v", err)
 		return
@@ -1156,7 +1283,7 @@ func (p *Proxy) StateLookupID(in0 context.Context, in1 address.Address, in2 type
 }
 
 func (p *Proxy) StateLookupRobustAddress(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 address.Address, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateLookupRobustAddress %v", err)
 		return
@@ -1165,7 +1292,7 @@ func (p *Proxy) StateLookupRobustAddress(in0 context.Context, in1 address.Addres
 }
 
 func (p *Proxy) StateMarketBalance(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 api1.MarketBalance, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateMarketBalance %v", err)
 		return
@@ -1174,7 +1301,7 @@ func (p *Proxy) StateMarketBalance(in0 context.Context, in1 address.Address, in2
 }
 
 func (p *Proxy) StateMarketDeals(in0 context.Context, in1 types.TipSetKey) (out0 map[string]*api1.MarketDeal, err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api StateMarketDeals %v", err)
 		return
@@ -1183,7 +1310,7 @@ func (p *Proxy) StateMarketDeals(in0 context.Context, in1 types.TipSetKey) (out0
 }
 
 func (p *Proxy) StateMarketParticipants(in0 context.Context, in1 types.TipSetKey) (out0 map[string]api1.MarketBalance, err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api StateMarketParticipants %v", err)
 		return
@@ -1192,7 +1319,7 @@ func (p *Proxy) StateMarketParticipants(in0 context.Context, in1 types.TipSetKey
 }
 
 func (p *Proxy) StateMarketProposalPending(in0 context.Context, in1 cid.Cid, in2 types.TipSetKey) (out0 bool, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateMarketProposalPending %v", err)
 		return
@@ -1201,7 +1328,7 @@ func (p *Proxy) StateMarketProposalPending(in0 context.Context, in1 cid.Cid, in2
 }
 
 func (p *Proxy) StateMarketStorageDeal(in0 context.Context, in1 abi.DealID, in2 types.TipSetKey) (out0 *api1.MarketDeal, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateMarketStorageDeal %v", err)
 		return
@@ -1210,7 +1337,7 @@ func (p *Proxy) StateMarketStorageDeal(in0 context.Context, in1 abi.DealID, in2
 }
 
 func (p *Proxy) StateMinerActiveSectors(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 []*miner.SectorOnChainInfo, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateMinerActiveSectors %v", err)
 		return
@@ -1219,7 +1346,7 @@ func (p *Proxy) StateMinerActiveSectors(in0 context.Context, in1 address.Address
 }
 
 func (p *Proxy) StateMinerAllocated(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *bitfield.BitField, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateMinerAllocated %v", err)
 		return
@@ -1228,7 +1355,7 @@ func (p *Proxy) StateMinerAllocated(in0 context.Context, in1 address.Address, in
 }
 
 func (p *Proxy) StateMinerAvailableBalance(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateMinerAvailableBalance %v", err)
 		return
@@ -1237,7 +1364,7 @@ func (p *Proxy) StateMinerAvailableBalance(in0 context.Context, in1 address.Addr
 }
 
 func (p *Proxy) StateMinerCreationDeposit(in0 context.Context, in1 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api StateMinerCreationDeposit %v", err)
 		return
@@ -1246,7 +1373,7 @@ func (p *Proxy) StateMinerCreationDeposit(in0 context.Context, in1 types.TipSetK
 }
 
 func (p *Proxy) StateMinerDeadlines(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 []api1.Deadline, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateMinerDeadlines %v", err)
 		return
@@ -1255,7 +1382,7 @@ func (p *Proxy) StateMinerDeadlines(in0 context.Context, in1 address.Address, in
 }
 
 func (p *Proxy) StateMinerFaults(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 bitfield.BitField, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateMinerFaults %v", err)
 		return
@@ -1264,7 +1391,7 @@ func (p *Proxy) StateMinerFaults(in0 context.Context, in1 address.Address, in2 t
 }
 
 func (p *Proxy) StateMinerInfo(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 api1.MinerInfo, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateMinerInfo %v", err)
 		return
@@ -1273,7 +1400,7 @@ func (p *Proxy) StateMinerInfo(in0 context.Context, in1 address.Address, in2 typ
 }
 
 func (p *Proxy) StateMinerInitialPledgeCollateral(in0 context.Context, in1 address.Address, in2 miner1.SectorPreCommitInfo, in3 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select(in3)
+	cli, err := p.Select(in0, in3)
 	if err != nil {
 		err = fmt.Errorf("api StateMinerInitialPledgeCollateral %v", err)
 		return
@@ -1282,7 +1409,7 @@ func (p *Proxy) StateMinerInitialPledgeCollateral(in0 context.Context, in1 addre
 }
 
 func (p *Proxy) StateMinerInitialPledgeForSector(in0 context.Context, in1 abi.ChainEpoch, in2 abi.SectorSize, in3 uint64, in4 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select(in4)
+	cli, err := p.Select(in0, in4)
 	if err != nil {
 		err = fmt.Errorf("api StateMinerInitialPledgeForSector %v", err)
 		return
@@ -1291,7 +1418,7 @@ func (p *Proxy) StateMinerInitialPledgeForSector(in0 context.Context, in1 abi.Ch
 }
 
 func (p *Proxy) StateMinerPartitions(in0 context.Context, in1 address.Address, in2 uint64, in3 types.TipSetKey) (out0 []api1.Partition, err error) {
-	cli, err := p.Select(in3)
+	cli, err := p.Select(in0, in3)
 	if err != nil {
 		err = fmt.Errorf("api StateMinerPartitions %v", err)
 		return
@@ -1300,7 +1427,7 @@ func (p *Proxy) StateMinerPartitions(in0 context.Context, in1 address.Address, i
 }
 
 func (p *Proxy) StateMinerPower(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *api1.MinerPower, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateMinerPower %v", err)
 		return
@@ -1309,7 +1436,7 @@ func (p *Proxy) StateMinerPower(in0 context.Context, in1 address.Address, in2 ty
 }
 
 func (p *Proxy) StateMinerPreCommitDepositForPower(in0 context.Context, in1 address.Address, in2 miner1.SectorPreCommitInfo, in3 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select(in3)
+	cli, err := p.Select(in0, in3)
 	if err != nil {
 		err = fmt.Errorf("api StateMinerPreCommitDepositForPower %v", err)
 		return
@@ -1318,7 +1445,7 @@ func (p *Proxy) StateMinerPreCommitDepositForPower(in0 context.Context, in1 addr
 }
 
 func (p *Proxy) StateMinerProvingDeadline(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *dline.Info, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateMinerProvingDeadline %v", err)
 		return
@@ -1327,7 +1454,7 @@ func (p *Proxy) StateMinerProvingDeadline(in0 context.Context, in1 address.Addre
 }
 
 func (p *Proxy) StateMinerRecoveries(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 bitfield.BitField, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateMinerRecoveries %v", err)
 		return
@@ -1336,7 +1463,7 @@ func (p *Proxy) StateMinerRecoveries(in0 context.Context, in1 address.Address, i
 }
 
 func (p *Proxy) StateMinerSectorAllocated(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 bool, err error) {
-	cli, err := p.Select(in3)
+	cli, err := p.Select(in0, in3)
 	if err != nil {
 		err = fmt.Errorf("api StateMinerSectorAllocated %v", err)
 		return
@@ -1345,7 +1472,7 @@ func (p *Proxy) StateMinerSectorAllocated(in0 context.Context, in1 address.Addre
 }
 
 func (p *Proxy) StateMinerSectorCount(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 api1.MinerSectors, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateMinerSectorCount %v", err)
 		return
@@ -1354,7 +1481,7 @@ func (p *Proxy) StateMinerSectorCount(in0 context.Context, in1 address.Address,
 }
 
 func (p *Proxy) StateMinerSectors(in0 context.Context, in1 address.Address, in2 *bitfield.BitField, in3 types.TipSetKey) (out0 []*miner.SectorOnChainInfo, err error) {
-	cli, err := p.Select(in3)
+	cli, err := p.Select(in0, in3)
 	if err != nil {
 		err = fmt.Errorf("api StateMinerSectors %v", err)
 		return
@@ -1363,7 +1490,7 @@ func (p *Proxy) StateMinerSectors(in0 context.Context, in1 address.Address, in2
 }
 
 func (p *Proxy) StateNetworkName(in0 context.Context) (out0 dtypes.NetworkName, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api StateNetworkName %v", err)
 		return
@@ -1372,7 +1499,7 @@ func (p *Proxy) StateNetworkName(in0 context.Context) (out0 dtypes.NetworkName,
 }
 
 func (p *Proxy) StateNetworkVersion(in0 context.Context, in1 types.TipSetKey) (out0 network.Version, err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api StateNetworkVersion %v", err)
 		return
@@ -1381,7 +1508,7 @@ func (p *Proxy) StateNetworkVersion(in0 context.Context, in1 types.TipSetKey) (o
 }
 
 func (p *Proxy) StateReadState(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *api1.ActorState, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateReadState %v", err)
 		return
@@ -1389,17 +1516,17 @@ func (p *Proxy) StateReadState(in0 context.Context, in1 address.Address, in2 typ
 	return cli.StateReadState(in0, in1, in2)
 }
 
-func (p *Proxy) StateSearchMsg(in0 context.Context, in1 types.TipSetKey, in2 cid.Cid, in3 abi.ChainEpoch, in4 bool) (out0 *api1.MsgLookup, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+func (p *Proxy) StateReplay(in0 context.Context, in1 types.TipSetKey, in2 cid.Cid) (out0 *api1.InvocResult, err error) {
+	cli, err := p.Select(in0, in1)
 	if err != nil {
-		err = fmt.Errorf("api StateSearchMsg %v", err)
+		err = fmt.Errorf("api StateReplay %v", err)
 		return
 	}
-	return cli.StateSearchMsg(in0, in1, in2, in3, in4)
+	return cli.StateReplay(in0, in1, in2)
 }
 
 func (p *Proxy) StateSectorExpiration(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 *miner2.SectorExpiration, err error) {
-	cli, err := p.Select(in3)
+	cli, err := p.Select(in0, in3)
 	if err != nil {
 		err = fmt.Errorf("api StateSectorExpiration %v", err)
 		return
@@ -1408,7 +1535,7 @@ func (p *Proxy) StateSectorExpiration(in0 context.Context, in1 address.Address,
 }
 
 func (p *Proxy) StateSectorGetInfo(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 *miner.SectorOnChainInfo, err error) {
-	cli, err := p.Select(in3)
+	cli, err := p.Select(in0, in3)
 	if err != nil {
 		err = fmt.Errorf("api StateSectorGetInfo %v", err)
 		return
@@ -1417,7 +1544,7 @@ func (p *Proxy) StateSectorGetInfo(in0 context.Context, in1 address.Address, in2
 }
 
 func (p *Proxy) StateSectorPartition(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 *miner2.SectorLocation, err error) {
-	cli, err := p.Select(in3)
+	cli, err := p.Select(in0, in3)
 	if err != nil {
 		err = fmt.Errorf("api StateSectorPartition %v", err)
 		return
@@ -1426,7 +1553,7 @@ func (p *Proxy) StateSectorPartition(in0 context.Context, in1 address.Address, i
 }
 
 func (p *Proxy) StateSectorPreCommitInfo(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 *miner1.SectorPreCommitOnChainInfo, err error) {
-	cli, err := p.Select(in3)
+	cli, err := p.Select(in0, in3)
 	if err != nil {
 		err = fmt.Errorf("api StateSectorPreCommitInfo %v", err)
 		return
@@ -1435,7 +1562,7 @@ func (p *Proxy) StateSectorPreCommitInfo(in0 context.Context, in1 address.Addres
 }
 
 func (p *Proxy) StateVMCirculatingSupplyInternal(in0 context.Context, in1 types.TipSetKey) (out0 api1.CirculatingSupply, err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api StateVMCirculatingSupplyInternal %v", err)
 		return
@@ -1444,7 +1571,7 @@ func (p *Proxy) StateVMCirculatingSupplyInternal(in0 context.Context, in1 types.
 }
 
 func (p *Proxy) StateVerifiedClientStatus(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *big.Int, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateVerifiedClientStatus %v", err)
 		return
@@ -1453,7 +1580,7 @@ func (p *Proxy) StateVerifiedClientStatus(in0 context.Context, in1 address.Addre
 }
 
 func (p *Proxy) StateVerifiedRegistryRootKey(in0 context.Context, in1 types.TipSetKey) (out0 address.Address, err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api StateVerifiedRegistryRootKey %v", err)
 		return
@@ -1462,7 +1589,7 @@ func (p *Proxy) StateVerifiedRegistryRootKey(in0 context.Context, in1 types.TipS
 }
 
 func (p *Proxy) StateVerifierStatus(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *big.Int, err error) {
-	cli, err := p.Select(in2)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api StateVerifierStatus %v", err)
 		return
@@ -1470,26 +1597,26 @@ func (p *Proxy) StateVerifierStatus(in0 context.Context, in1 address.Address, in
 	return cli.StateVerifierStatus(in0, in1, in2)
 }
 
-func (p *Proxy) StateWaitMsg(in0 context.Context, in1 cid.Cid, in2 uint64, in3 abi.ChainEpoch, in4 bool) (out0 *api1.MsgLookup, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+func (p *Proxy) SubscribeActorEventsRaw(in0 context.Context, in1 *types.ActorEventFilter) (out0 <-chan *types.ActorEvent, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
-		err = fmt.Errorf("api StateWaitMsg %v", err)
+		err = fmt.Errorf("api SubscribeActorEventsRaw %v", err)
 		return
 	}
-	return cli.StateWaitMsg(in0, in1, in2, in3, in4)
+	return cli.SubscribeActorEventsRaw(in0, in1)
 }
 
-func (p *Proxy) SubscribeActorEventsRaw(in0 context.Context, in1 *types.ActorEventFilter) (out0 <-chan *types.ActorEvent, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+func (p *Proxy) SyncCheckBad(in0 context.Context, in1 cid.Cid) (out0 string, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
-		err = fmt.Errorf("api SubscribeActorEventsRaw %v", err)
+		err = fmt.Errorf("api SyncCheckBad %v", err)
 		return
 	}
-	return cli.SubscribeActorEventsRaw(in0, in1)
+	return cli.SyncCheckBad(in0, in1)
 }
 
 func (p *Proxy) SyncIncomingBlocks(in0 context.Context) (out0 <-chan *types.BlockHeader, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api SyncIncomingBlocks %v", err)
 		return
@@ -1498,7 +1625,7 @@ func (p *Proxy) SyncIncomingBlocks(in0 context.Context) (out0 <-chan *types.Bloc
 }
 
 func (p *Proxy) SyncState(in0 context.Context) (out0 *api1.SyncState, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api SyncState %v", err)
 		return
@@ -1507,7 +1634,7 @@ func (p *Proxy) SyncState(in0 context.Context) (out0 *api1.SyncState, err error)
 }
 
 func (p *Proxy) SyncSubmitBlock(in0 context.Context, in1 *types.BlockMsg) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api SyncSubmitBlock %v", err)
 		return
@@ -1515,8 +1642,17 @@ func (p *Proxy) SyncSubmitBlock(in0 context.Context, in1 *types.BlockMsg) (err e
 	return cli.SyncSubmitBlock(in0, in1)
 }
 
+func (p *Proxy) SyncValidateTipset(in0 context.Context, in1 types.TipSetKey) (out0 bool, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api SyncValidateTipset %v", err)
+		return
+	}
+	return cli.SyncValidateTipset(in0, in1)
+}
+
 func (p *Proxy) Version(in0 context.Context) (out0 api1.APIVersion, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api Version %v", err)
 		return
@@ -1525,7 +1661,7 @@ func (p *Proxy) Version(in0 context.Context) (out0 api1.APIVersion, err error) {
 }
 
 func (p *Proxy) WalletBalance(in0 context.Context, in1 address.Address) (out0 big.Int, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api WalletBalance %v", err)
 		return
@@ -1534,7 +1670,7 @@ func (p *Proxy) WalletBalance(in0 context.Context, in1 address.Address) (out0 bi
 }
 
 func (p *Proxy) WalletHas(in0 context.Context, in1 address.Address) (out0 bool, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api WalletHas %v", err)
 		return
@@ -1543,7 +1679,7 @@ func (p *Proxy) WalletHas(in0 context.Context, in1 address.Address) (out0 bool,
 }
 
 func (p *Proxy) WalletSign(in0 context.Context, in1 address.Address, in2 []uint8) (out0 *crypto.Signature, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api WalletSign %v", err)
 		return
@@ -1552,7 +1688,7 @@ func (p *Proxy) WalletSign(in0 context.Context, in1 address.Address, in2 []uint8
 }
 
 func (p *Proxy) Web3ClientVersion(in0 context.Context) (out0 string, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api Web3ClientVersion %v", err)
 		return
@@ -2,7 +2,6 @@ package proxy
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
@@ -12,10 +11,8 @@ import (
 	"github.com/filecoin-project/go-state-types/builtin/v8/paych"
 	"github.com/filecoin-project/go-state-types/crypto"
 	api1 "github.com/filecoin-project/lotus/api"
-	apitypes "github.com/filecoin-project/lotus/api/types"
 	"github.com/filecoin-project/lotus/chain/types"
 	"github.com/filecoin-project/lotus/journal/alerting"
-	"github.com/google/uuid"
 	"github.com/ipfs-force-community/sophon-co/api"
 	blocks "github.com/ipfs/go-block-format"
 	"github.com/ipfs/go-cid"
@@ -32,12 +29,12 @@ type UnSupportAPI interface {
 }
 
 type UnSupport struct {
-	Select func(types.TipSetKey) (UnSupportAPI, error)
+	Select func(context.Context, types.TipSetKey) (UnSupportAPI, error)
 }
 
 // impl api.UnSupport
 func (p *UnSupport) AuthNew(in0 context.Context, in1 []string) (out0 []uint8, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api AuthNew %v", err)
 		return
@@ -46,7 +43,7 @@ func (p *UnSupport) AuthNew(in0 context.Context, in1 []string) (out0 []uint8, er
 }
 
 func (p *UnSupport) AuthVerify(in0 context.Context, in1 string) (out0 []string, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api AuthVerify %v", err)
 		return
@@ -54,17 +51,8 @@ func (p *UnSupport) AuthVerify(in0 context.Context, in1 string) (out0 []string,
 	return cli.AuthVerify(in0, in1)
 }
 
-func (p *UnSupport) ChainBlockstoreInfo(in0 context.Context) (out0 map[string]interface{}, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api ChainBlockstoreInfo %v", err)
-		return
-	}
-	return cli.ChainBlockstoreInfo(in0)
-}
-
 func (p *UnSupport) ChainCheckBlockstore(in0 context.Context) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainCheckBlockstore %v", err)
 		return
@@ -73,7 +61,7 @@ func (p *UnSupport) ChainCheckBlockstore(in0 context.Context) (err error) {
 }
 
 func (p *UnSupport) ChainDeleteObj(in0 context.Context, in1 cid.Cid) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainDeleteObj %v", err)
 		return
@@ -81,17 +69,8 @@ func (p *UnSupport) ChainDeleteObj(in0 context.Context, in1 cid.Cid) (err error)
 	return cli.ChainDeleteObj(in0, in1)
 }
 
-func (p *UnSupport) ChainExport(in0 context.Context, in1 abi.ChainEpoch, in2 bool, in3 types.TipSetKey) (out0 <-chan []uint8, err error) {
-	cli, err := p.Select(in3)
-	if err != nil {
-		err = fmt.Errorf("api ChainExport %v", err)
-		return
-	}
-	return cli.ChainExport(in0, in1, in2, in3)
-}
-
 func (p *UnSupport) ChainExportRangeInternal(in0 context.Context, in1 types.TipSetKey, in2 types.TipSetKey, in3 api1.ChainExportConfig) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, in2)
 	if err != nil {
 		err = fmt.Errorf("api ChainExportRangeInternal %v", err)
 		return
@@ -99,17 +78,8 @@ func (p *UnSupport) ChainExportRangeInternal(in0 context.Context, in1 types.TipS
 	return cli.ChainExportRangeInternal(in0, in1, in2, in3)
 }
 
-func (p *UnSupport) ChainGetNode(in0 context.Context, in1 string) (out0 *api1.IpldObject, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api ChainGetNode %v", err)
-		return
-	}
-	return cli.ChainGetNode(in0, in1)
-}
-
 func (p *UnSupport) ChainHotGC(in0 context.Context, in1 api1.HotGCOpts) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainHotGC %v", err)
 		return
@@ -118,7 +88,7 @@ func (p *UnSupport) ChainHotGC(in0 context.Context, in1 api1.HotGCOpts) (err err
 }
 
 func (p *UnSupport) ChainPrune(in0 context.Context, in1 api1.PruneOpts) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainPrune %v", err)
 		return
@@ -127,7 +97,7 @@ func (p *UnSupport) ChainPrune(in0 context.Context, in1 api1.PruneOpts) (err err
 }
 
 func (p *UnSupport) ChainPutObj(in0 context.Context, in1 blocks.Block) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api ChainPutObj %v", err)
 		return
@@ -136,7 +106,7 @@ func (p *UnSupport) ChainPutObj(in0 context.Context, in1 blocks.Block) (err erro
 }
 
 func (p *UnSupport) ChainSetHead(in0 context.Context, in1 types.TipSetKey) (err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api ChainSetHead %v", err)
 		return
@@ -144,17 +114,8 @@ func (p *UnSupport) ChainSetHead(in0 context.Context, in1 types.TipSetKey) (err
 	return cli.ChainSetHead(in0, in1)
 }
 
-func (p *UnSupport) Closing(in0 context.Context) (out0 <-chan struct{}, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api Closing %v", err)
-		return
-	}
-	return cli.Closing(in0)
-}
-
 func (p *UnSupport) CreateBackup(in0 context.Context, in1 string) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api CreateBackup %v", err)
 		return
@@ -162,26 +123,8 @@ func (p *UnSupport) CreateBackup(in0 context.Context, in1 string) (err error) {
 	return cli.CreateBackup(in0, in1)
 }
 
-func (p *UnSupport) Discover(in0 context.Context) (out0 apitypes.OpenRPCDocument, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api Discover %v", err)
-		return
-	}
-	return cli.Discover(in0)
-}
-
-func (p *UnSupport) ID(in0 context.Context) (out0 peer.ID, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api ID %v", err)
-		return
-	}
-	return cli.ID(in0)
-}
-
 func (p *UnSupport) LogAlerts(in0 context.Context) (out0 []alerting.Alert, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api LogAlerts %v", err)
 		return
@@ -189,26 +132,8 @@ func (p *UnSupport) LogAlerts(in0 context.Context) (out0 []alerting.Alert, err e
 	return cli.LogAlerts(in0)
 }
 
-func (p *UnSupport) LogList(in0 context.Context) (out0 []string, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api LogList %v", err)
-		return
-	}
-	return cli.LogList(in0)
-}
-
-func (p *UnSupport) LogSetLevel(in0 context.Context, in1 string, in2 string) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api LogSetLevel %v", err)
-		return
-	}
-	return cli.LogSetLevel(in0, in1, in2)
-}
-
 func (p *UnSupport) MarketAddBalance(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int) (out0 cid.Cid, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MarketAddBalance %v", err)
 		return
@@ -217,7 +142,7 @@ func (p *UnSupport) MarketAddBalance(in0 context.Context, in1 address.Address, i
 }
 
 func (p *UnSupport) MarketGetReserved(in0 context.Context, in1 address.Address) (out0 big.Int, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MarketGetReserved %v", err)
 		return
@@ -226,7 +151,7 @@ func (p *UnSupport) MarketGetReserved(in0 context.Context, in1 address.Address)
 }
 
 func (p *UnSupport) MarketReleaseFunds(in0 context.Context, in1 address.Address, in2 big.Int) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MarketReleaseFunds %v", err)
 		return
@@ -235,7 +160,7 @@ func (p *UnSupport) MarketReleaseFunds(in0 context.Context, in1 address.Address,
 }
 
 func (p *UnSupport) MarketReserveFunds(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int) (out0 cid.Cid, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MarketReserveFunds %v", err)
 		return
@@ -244,7 +169,7 @@ func (p *UnSupport) MarketReserveFunds(in0 context.Context, in1 address.Address,
 }
 
 func (p *UnSupport) MarketWithdraw(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int) (out0 cid.Cid, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MarketWithdraw %v", err)
 		return
@@ -253,7 +178,7 @@ func (p *UnSupport) MarketWithdraw(in0 context.Context, in1 address.Address, in2
 }
 
 func (p *UnSupport) MpoolBatchPushMessage(in0 context.Context, in1 []*types.Message, in2 *api1.MessageSendSpec) (out0 []*types.SignedMessage, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MpoolBatchPushMessage %v", err)
 		return
@@ -261,35 +186,8 @@ func (p *UnSupport) MpoolBatchPushMessage(in0 context.Context, in1 []*types.Mess
 	return cli.MpoolBatchPushMessage(in0, in1, in2)
 }
 
-func (p *UnSupport) MpoolCheckMessages(in0 context.Context, in1 []*api1.MessagePrototype) (out0 [][]api1.MessageCheckStatus, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api MpoolCheckMessages %v", err)
-		return
-	}
-	return cli.MpoolCheckMessages(in0, in1)
-}
-
-func (p *UnSupport) MpoolCheckPendingMessages(in0 context.Context, in1 address.Address) (out0 [][]api1.MessageCheckStatus, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api MpoolCheckPendingMessages %v", err)
-		return
-	}
-	return cli.MpoolCheckPendingMessages(in0, in1)
-}
-
-func (p *UnSupport) MpoolCheckReplaceMessages(in0 context.Context, in1 []*types.Message) (out0 [][]api1.MessageCheckStatus, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api MpoolCheckReplaceMessages %v", err)
-		return
-	}
-	return cli.MpoolCheckReplaceMessages(in0, in1)
-}
-
 func (p *UnSupport) MpoolClear(in0 context.Context, in1 bool) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MpoolClear %v", err)
 		return
@@ -298,7 +196,7 @@ func (p *UnSupport) MpoolClear(in0 context.Context, in1 bool) (err error) {
 }
 
 func (p *UnSupport) MpoolPushUntrusted(in0 context.Context, in1 *types.SignedMessage) (out0 cid.Cid, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MpoolPushUntrusted %v", err)
 		return
@@ -307,7 +205,7 @@ func (p *UnSupport) MpoolPushUntrusted(in0 context.Context, in1 *types.SignedMes
 }
 
 func (p *UnSupport) MpoolSetConfig(in0 context.Context, in1 *types.MpoolConfig) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MpoolSetConfig %v", err)
 		return
@@ -315,17 +213,8 @@ func (p *UnSupport) MpoolSetConfig(in0 context.Context, in1 *types.MpoolConfig)
 	return cli.MpoolSetConfig(in0, in1)
 }
 
-func (p *UnSupport) MpoolSub(in0 context.Context) (out0 <-chan api1.MpoolUpdate, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api MpoolSub %v", err)
-		return
-	}
-	return cli.MpoolSub(in0)
-}
-
 func (p *UnSupport) MsigAddApprove(in0 context.Context, in1 address.Address, in2 address.Address, in3 uint64, in4 address.Address, in5 address.Address, in6 bool) (out0 *api1.MessagePrototype, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MsigAddApprove %v", err)
 		return
@@ -334,7 +223,7 @@ func (p *UnSupport) MsigAddApprove(in0 context.Context, in1 address.Address, in2
 }
 
 func (p *UnSupport) MsigAddCancel(in0 context.Context, in1 address.Address, in2 address.Address, in3 uint64, in4 address.Address, in5 bool) (out0 *api1.MessagePrototype, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MsigAddCancel %v", err)
 		return
@@ -343,7 +232,7 @@ func (p *UnSupport) MsigAddCancel(in0 context.Context, in1 address.Address, in2
 }
 
 func (p *UnSupport) MsigAddPropose(in0 context.Context, in1 address.Address, in2 address.Address, in3 address.Address, in4 bool) (out0 *api1.MessagePrototype, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MsigAddPropose %v", err)
 		return
@@ -352,7 +241,7 @@ func (p *UnSupport) MsigAddPropose(in0 context.Context, in1 address.Address, in2
 }
 
 func (p *UnSupport) MsigApprove(in0 context.Context, in1 address.Address, in2 uint64, in3 address.Address) (out0 *api1.MessagePrototype, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MsigApprove %v", err)
 		return
@@ -361,7 +250,7 @@ func (p *UnSupport) MsigApprove(in0 context.Context, in1 address.Address, in2 ui
 }
 
 func (p *UnSupport) MsigApproveTxnHash(in0 context.Context, in1 address.Address, in2 uint64, in3 address.Address, in4 address.Address, in5 big.Int, in6 address.Address, in7 uint64, in8 []uint8) (out0 *api1.MessagePrototype, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MsigApproveTxnHash %v", err)
 		return
@@ -370,7 +259,7 @@ func (p *UnSupport) MsigApproveTxnHash(in0 context.Context, in1 address.Address,
 }
 
 func (p *UnSupport) MsigCancel(in0 context.Context, in1 address.Address, in2 uint64, in3 address.Address) (out0 *api1.MessagePrototype, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MsigCancel %v", err)
 		return
@@ -379,7 +268,7 @@ func (p *UnSupport) MsigCancel(in0 context.Context, in1 address.Address, in2 uin
 }
 
 func (p *UnSupport) MsigCancelTxnHash(in0 context.Context, in1 address.Address, in2 uint64, in3 address.Address, in4 big.Int, in5 address.Address, in6 uint64, in7 []uint8) (out0 *api1.MessagePrototype, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MsigCancelTxnHash %v", err)
 		return
@@ -388,7 +277,7 @@ func (p *UnSupport) MsigCancelTxnHash(in0 context.Context, in1 address.Address,
 }
 
 func (p *UnSupport) MsigCreate(in0 context.Context, in1 uint64, in2 []address.Address, in3 abi.ChainEpoch, in4 big.Int, in5 address.Address, in6 big.Int) (out0 *api1.MessagePrototype, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MsigCreate %v", err)
 		return
@@ -396,44 +285,8 @@ func (p *UnSupport) MsigCreate(in0 context.Context, in1 uint64, in2 []address.Ad
 	return cli.MsigCreate(in0, in1, in2, in3, in4, in5, in6)
 }
 
-func (p *UnSupport) MsigGetAvailableBalance(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select(in2)
-	if err != nil {
-		err = fmt.Errorf("api MsigGetAvailableBalance %v", err)
-		return
-	}
-	return cli.MsigGetAvailableBalance(in0, in1, in2)
-}
-
-func (p *UnSupport) MsigGetPending(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 []*api1.MsigTransaction, err error) {
-	cli, err := p.Select(in2)
-	if err != nil {
-		err = fmt.Errorf("api MsigGetPending %v", err)
-		return
-	}
-	return cli.MsigGetPending(in0, in1, in2)
-}
-
-func (p *UnSupport) MsigGetVested(in0 context.Context, in1 address.Address, in2 types.TipSetKey, in3 types.TipSetKey) (out0 big.Int, err error) {
-	cli, err := p.Select(in3)
-	if err != nil {
-		err = fmt.Errorf("api MsigGetVested %v", err)
-		return
-	}
-	return cli.MsigGetVested(in0, in1, in2, in3)
-}
-
-func (p *UnSupport) MsigGetVestingSchedule(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 api1.MsigVesting, err error) {
-	cli, err := p.Select(in2)
-	if err != nil {
-		err = fmt.Errorf("api MsigGetVestingSchedule %v", err)
-		return
-	}
-	return cli.MsigGetVestingSchedule(in0, in1, in2)
-}
-
 func (p *UnSupport) MsigPropose(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int, in4 address.Address, in5 uint64, in6 []uint8) (out0 *api1.MessagePrototype, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MsigPropose %v", err)
 		return
@@ -442,7 +295,7 @@ func (p *UnSupport) MsigPropose(in0 context.Context, in1 address.Address, in2 ad
 }
 
 func (p *UnSupport) MsigRemoveSigner(in0 context.Context, in1 address.Address, in2 address.Address, in3 address.Address, in4 bool) (out0 *api1.MessagePrototype, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MsigRemoveSigner %v", err)
 		return
@@ -451,7 +304,7 @@ func (p *UnSupport) MsigRemoveSigner(in0 context.Context, in1 address.Address, i
 }
 
 func (p *UnSupport) MsigSwapApprove(in0 context.Context, in1 address.Address, in2 address.Address, in3 uint64, in4 address.Address, in5 address.Address, in6 address.Address) (out0 *api1.MessagePrototype, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MsigSwapApprove %v", err)
 		return
@@ -460,7 +313,7 @@ func (p *UnSupport) MsigSwapApprove(in0 context.Context, in1 address.Address, in
 }
 
 func (p *UnSupport) MsigSwapCancel(in0 context.Context, in1 address.Address, in2 address.Address, in3 uint64, in4 address.Address, in5 address.Address) (out0 *api1.MessagePrototype, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MsigSwapCancel %v", err)
 		return
@@ -469,7 +322,7 @@ func (p *UnSupport) MsigSwapCancel(in0 context.Context, in1 address.Address, in2
 }
 
 func (p *UnSupport) MsigSwapPropose(in0 context.Context, in1 address.Address, in2 address.Address, in3 address.Address, in4 address.Address) (out0 *api1.MessagePrototype, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api MsigSwapPropose %v", err)
 		return
@@ -478,7 +331,7 @@ func (p *UnSupport) MsigSwapPropose(in0 context.Context, in1 address.Address, in
 }
 
 func (p *UnSupport) NetAgentVersion(in0 context.Context, in1 peer.ID) (out0 string, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetAgentVersion %v", err)
 		return
@@ -487,7 +340,7 @@ func (p *UnSupport) NetAgentVersion(in0 context.Context, in1 peer.ID) (out0 stri
 }
 
 func (p *UnSupport) NetAutoNatStatus(in0 context.Context) (out0 api1.NatInfo, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetAutoNatStatus %v", err)
 		return
@@ -496,7 +349,7 @@ func (p *UnSupport) NetAutoNatStatus(in0 context.Context) (out0 api1.NatInfo, er
 }
 
 func (p *UnSupport) NetBandwidthStats(in0 context.Context) (out0 metrics.Stats, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetBandwidthStats %v", err)
 		return
@@ -505,7 +358,7 @@ func (p *UnSupport) NetBandwidthStats(in0 context.Context) (out0 metrics.Stats,
 }
 
 func (p *UnSupport) NetBandwidthStatsByPeer(in0 context.Context) (out0 map[string]metrics.Stats, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetBandwidthStatsByPeer %v", err)
 		return
@@ -514,7 +367,7 @@ func (p *UnSupport) NetBandwidthStatsByPeer(in0 context.Context) (out0 map[strin
 }
 
 func (p *UnSupport) NetBandwidthStatsByProtocol(in0 context.Context) (out0 map[protocol.ID]metrics.Stats, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetBandwidthStatsByProtocol %v", err)
 		return
@@ -523,7 +376,7 @@ func (p *UnSupport) NetBandwidthStatsByProtocol(in0 context.Context) (out0 map[p
 }
 
 func (p *UnSupport) NetBlockAdd(in0 context.Context, in1 api1.NetBlockList) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetBlockAdd %v", err)
 		return
@@ -532,7 +385,7 @@ func (p *UnSupport) NetBlockAdd(in0 context.Context, in1 api1.NetBlockList) (err
 }
 
 func (p *UnSupport) NetBlockList(in0 context.Context) (out0 api1.NetBlockList, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetBlockList %v", err)
 		return
@@ -541,7 +394,7 @@ func (p *UnSupport) NetBlockList(in0 context.Context) (out0 api1.NetBlockList, e
 }
 
 func (p *UnSupport) NetBlockRemove(in0 context.Context, in1 api1.NetBlockList) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetBlockRemove %v", err)
 		return
@@ -550,7 +403,7 @@ func (p *UnSupport) NetBlockRemove(in0 context.Context, in1 api1.NetBlockList) (
 }
 
 func (p *UnSupport) NetConnect(in0 context.Context, in1 peer.AddrInfo) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetConnect %v", err)
 		return
@@ -559,7 +412,7 @@ func (p *UnSupport) NetConnect(in0 context.Context, in1 peer.AddrInfo) (err erro
 }
 
 func (p *UnSupport) NetConnectedness(in0 context.Context, in1 peer.ID) (out0 network.Connectedness, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetConnectedness %v", err)
 		return
@@ -568,7 +421,7 @@ func (p *UnSupport) NetConnectedness(in0 context.Context, in1 peer.ID) (out0 net
 }
 
 func (p *UnSupport) NetDisconnect(in0 context.Context, in1 peer.ID) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetDisconnect %v", err)
 		return
@@ -577,7 +430,7 @@ func (p *UnSupport) NetDisconnect(in0 context.Context, in1 peer.ID) (err error)
 }
 
 func (p *UnSupport) NetFindPeer(in0 context.Context, in1 peer.ID) (out0 peer.AddrInfo, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetFindPeer %v", err)
 		return
@@ -586,7 +439,7 @@ func (p *UnSupport) NetFindPeer(in0 context.Context, in1 peer.ID) (out0 peer.Add
 }
 
 func (p *UnSupport) NetLimit(in0 context.Context, in1 string) (out0 api1.NetLimit, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetLimit %v", err)
 		return
@@ -595,7 +448,7 @@ func (p *UnSupport) NetLimit(in0 context.Context, in1 string) (out0 api1.NetLimi
 }
 
 func (p *UnSupport) NetPeerInfo(in0 context.Context, in1 peer.ID) (out0 *api1.ExtendedPeerInfo, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetPeerInfo %v", err)
 		return
@@ -604,7 +457,7 @@ func (p *UnSupport) NetPeerInfo(in0 context.Context, in1 peer.ID) (out0 *api1.Ex
 }
 
 func (p *UnSupport) NetPeers(in0 context.Context) (out0 []peer.AddrInfo, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetPeers %v", err)
 		return
@@ -613,7 +466,7 @@ func (p *UnSupport) NetPeers(in0 context.Context) (out0 []peer.AddrInfo, err err
 }
 
 func (p *UnSupport) NetPing(in0 context.Context, in1 peer.ID) (out0 time.Duration, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetPing %v", err)
 		return
@@ -622,7 +475,7 @@ func (p *UnSupport) NetPing(in0 context.Context, in1 peer.ID) (out0 time.Duratio
 }
 
 func (p *UnSupport) NetProtectList(in0 context.Context) (out0 []peer.ID, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetProtectList %v", err)
 		return
@@ -631,7 +484,7 @@ func (p *UnSupport) NetProtectList(in0 context.Context) (out0 []peer.ID, err err
 }
 
 func (p *UnSupport) NetProtectRemove(in0 context.Context, in1 []peer.ID) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetProtectRemove %v", err)
 		return
@@ -640,7 +493,7 @@ func (p *UnSupport) NetProtectRemove(in0 context.Context, in1 []peer.ID) (err er
 }
 
 func (p *UnSupport) NetPubsubScores(in0 context.Context) (out0 []api1.PubsubScore, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetPubsubScores %v", err)
 		return
@@ -649,7 +502,7 @@ func (p *UnSupport) NetPubsubScores(in0 context.Context) (out0 []api1.PubsubScor
 }
 
 func (p *UnSupport) NetSetLimit(in0 context.Context, in1 string, in2 api1.NetLimit) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetSetLimit %v", err)
 		return
@@ -658,7 +511,7 @@ func (p *UnSupport) NetSetLimit(in0 context.Context, in1 string, in2 api1.NetLim
 }
 
 func (p *UnSupport) NetStat(in0 context.Context, in1 string) (out0 api1.NetStat, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api NetStat %v", err)
 		return
@@ -666,17 +519,8 @@ func (p *UnSupport) NetStat(in0 context.Context, in1 string) (out0 api1.NetStat,
 	return cli.NetStat(in0, in1)
 }
 
-func (p *UnSupport) NodeStatus(in0 context.Context, in1 bool) (out0 api1.NodeStatus, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api NodeStatus %v", err)
-		return
-	}
-	return cli.NodeStatus(in0, in1)
-}
-
 func (p *UnSupport) PaychAllocateLane(in0 context.Context, in1 address.Address) (out0 uint64, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api PaychAllocateLane %v", err)
 		return
@@ -685,7 +529,7 @@ func (p *UnSupport) PaychAllocateLane(in0 context.Context, in1 address.Address)
 }
 
 func (p *UnSupport) PaychAvailableFunds(in0 context.Context, in1 address.Address) (out0 *api1.ChannelAvailableFunds, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api PaychAvailableFunds %v", err)
 		return
@@ -694,7 +538,7 @@ func (p *UnSupport) PaychAvailableFunds(in0 context.Context, in1 address.Address
 }
 
 func (p *UnSupport) PaychAvailableFundsByFromTo(in0 context.Context, in1 address.Address, in2 address.Address) (out0 *api1.ChannelAvailableFunds, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api PaychAvailableFundsByFromTo %v", err)
 		return
@@ -703,7 +547,7 @@ func (p *UnSupport) PaychAvailableFundsByFromTo(in0 context.Context, in1 address
 }
 
 func (p *UnSupport) PaychCollect(in0 context.Context, in1 address.Address) (out0 cid.Cid, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api PaychCollect %v", err)
 		return
@@ -712,7 +556,7 @@ func (p *UnSupport) PaychCollect(in0 context.Context, in1 address.Address) (out0
 }
 
 func (p *UnSupport) PaychFund(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int) (out0 *api1.ChannelInfo, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api PaychFund %v", err)
 		return
@@ -721,7 +565,7 @@ func (p *UnSupport) PaychFund(in0 context.Context, in1 address.Address, in2 addr
 }
 
 func (p *UnSupport) PaychGet(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int, in4 api1.PaychGetOpts) (out0 *api1.ChannelInfo, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api PaychGet %v", err)
 		return
@@ -730,7 +574,7 @@ func (p *UnSupport) PaychGet(in0 context.Context, in1 address.Address, in2 addre
 }
 
 func (p *UnSupport) PaychGetWaitReady(in0 context.Context, in1 cid.Cid) (out0 address.Address, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api PaychGetWaitReady %v", err)
 		return
@@ -738,17 +582,8 @@ func (p *UnSupport) PaychGetWaitReady(in0 context.Context, in1 cid.Cid) (out0 ad
 	return cli.PaychGetWaitReady(in0, in1)
 }
 
-func (p *UnSupport) PaychList(in0 context.Context) (out0 []address.Address, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api PaychList %v", err)
-		return
-	}
-	return cli.PaychList(in0)
-}
-
 func (p *UnSupport) PaychNewPayment(in0 context.Context, in1 address.Address, in2 address.Address, in3 []api1.VoucherSpec) (out0 *api1.PaymentInfo, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api PaychNewPayment %v", err)
 		return
@@ -757,7 +592,7 @@ func (p *UnSupport) PaychNewPayment(in0 context.Context, in1 address.Address, in
 }
 
 func (p *UnSupport) PaychSettle(in0 context.Context, in1 address.Address) (out0 cid.Cid, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api PaychSettle %v", err)
 		return
@@ -765,17 +600,8 @@ func (p *UnSupport) PaychSettle(in0 context.Context, in1 address.Address) (out0
 	return cli.PaychSettle(in0, in1)
 }
 
-func (p *UnSupport) PaychStatus(in0 context.Context, in1 address.Address) (out0 *api1.PaychStatus, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api PaychStatus %v", err)
-		return
-	}
-	return cli.PaychStatus(in0, in1)
-}
-
 func (p *UnSupport) PaychVoucherAdd(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher, in3 []uint8, in4 big.Int) (out0 big.Int, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api PaychVoucherAdd %v", err)
 		return
@@ -783,26 +609,8 @@ func (p *UnSupport) PaychVoucherAdd(in0 context.Context, in1 address.Address, in
 	return cli.PaychVoucherAdd(in0, in1, in2, in3, in4)
 }
 
-func (p *UnSupport) PaychVoucherCheckSpendable(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher, in3 []uint8, in4 []uint8) (out0 bool, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api PaychVoucherCheckSpendable %v", err)
-		return
-	}
-	return cli.PaychVoucherCheckSpendable(in0, in1, in2, in3, in4)
-}
-
-func (p *UnSupport) PaychVoucherCheckValid(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api PaychVoucherCheckValid %v", err)
-		return
-	}
-	return cli.PaychVoucherCheckValid(in0, in1, in2)
-}
-
 func (p *UnSupport) PaychVoucherCreate(in0 context.Context, in1 address.Address, in2 big.Int, in3 uint64) (out0 *api1.VoucherCreateResult, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api PaychVoucherCreate %v", err)
 		return
@@ -811,7 +619,7 @@ func (p *UnSupport) PaychVoucherCreate(in0 context.Context, in1 address.Address,
 }
 
 func (p *UnSupport) PaychVoucherList(in0 context.Context, in1 address.Address) (out0 []*paych.SignedVoucher, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api PaychVoucherList %v", err)
 		return
@@ -820,7 +628,7 @@ func (p *UnSupport) PaychVoucherList(in0 context.Context, in1 address.Address) (
 }
 
 func (p *UnSupport) PaychVoucherSubmit(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher, in3 []uint8, in4 []uint8) (out0 cid.Cid, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api PaychVoucherSubmit %v", err)
 		return
@@ -828,26 +636,8 @@ func (p *UnSupport) PaychVoucherSubmit(in0 context.Context, in1 address.Address,
 	return cli.PaychVoucherSubmit(in0, in1, in2, in3, in4)
 }
 
-func (p *UnSupport) RaftLeader(in0 context.Context) (out0 peer.ID, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api RaftLeader %v", err)
-		return
-	}
-	return cli.RaftLeader(in0)
-}
-
-func (p *UnSupport) Session(in0 context.Context) (out0 uuid.UUID, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api Session %v", err)
-		return
-	}
-	return cli.Session(in0)
-}
-
 func (p *UnSupport) Shutdown(in0 context.Context) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api Shutdown %v", err)
 		return
@@ -855,89 +645,8 @@ func (p *UnSupport) Shutdown(in0 context.Context) (err error) {
 	return cli.Shutdown(in0)
 }
 
-func (p *UnSupport) StartTime(in0 context.Context) (out0 time.Time, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api StartTime %v", err)
-		return
-	}
-	return cli.StartTime(in0)
-}
-
-func (p *UnSupport) StateCompute(in0 context.Context, in1 abi.ChainEpoch, in2 []*types.Message, in3 types.TipSetKey) (out0 *api1.ComputeStateOutput, err error) {
-	cli, err := p.Select(in3)
-	if err != nil {
-		err = fmt.Errorf("api StateCompute %v", err)
-		return
-	}
-	return cli.StateCompute(in0, in1, in2, in3)
-}
-
-func (p *UnSupport) StateDecodeParams(in0 context.Context, in1 address.Address, in2 abi.MethodNum, in3 []uint8, in4 types.TipSetKey) (out0 interface{}, err error) {
-	cli, err := p.Select(in4)
-	if err != nil {
-		err = fmt.Errorf("api StateDecodeParams %v", err)
-		return
-	}
-	return cli.StateDecodeParams(in0, in1, in2, in3, in4)
-}
-
-func (p *UnSupport) StateEncodeParams(in0 context.Context, in1 cid.Cid, in2 abi.MethodNum, in3 json.RawMessage) (out0 []uint8, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api StateEncodeParams %v", err)
-		return
-	}
-	return cli.StateEncodeParams(in0, in1, in2, in3)
-}
-
-func (p *UnSupport) StateListMessages(in0 context.Context, in1 *api1.MessageMatch, in2 types.TipSetKey, in3 abi.ChainEpoch) (out0 []cid.Cid, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api StateListMessages %v", err)
-		return
-	}
-	return cli.StateListMessages(in0, in1, in2, in3)
-}
-
-func (p *UnSupport) StateReplay(in0 context.Context, in1 types.TipSetKey, in2 cid.Cid) (out0 *api1.InvocResult, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api StateReplay %v", err)
-		return
-	}
-	return cli.StateReplay(in0, in1, in2)
-}
-
-func (p *UnSupport) StateSearchMsgLimited(in0 context.Context, in1 cid.Cid, in2 abi.ChainEpoch) (out0 *api1.MsgLookup, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api StateSearchMsgLimited %v", err)
-		return
-	}
-	return cli.StateSearchMsgLimited(in0, in1, in2)
-}
-
-func (p *UnSupport) StateWaitMsgLimited(in0 context.Context, in1 cid.Cid, in2 uint64, in3 abi.ChainEpoch) (out0 *api1.MsgLookup, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api StateWaitMsgLimited %v", err)
-		return
-	}
-	return cli.StateWaitMsgLimited(in0, in1, in2, in3)
-}
-
-func (p *UnSupport) SyncCheckBad(in0 context.Context, in1 cid.Cid) (out0 string, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api SyncCheckBad %v", err)
-		return
-	}
-	return cli.SyncCheckBad(in0, in1)
-}
-
 func (p *UnSupport) SyncCheckpoint(in0 context.Context, in1 types.TipSetKey) (err error) {
-	cli, err := p.Select(in1)
+	cli, err := p.Select(in0, in1)
 	if err != nil {
 		err = fmt.Errorf("api SyncCheckpoint %v", err)
 		return
@@ -946,7 +655,7 @@ func (p *UnSupport) SyncCheckpoint(in0 context.Context, in1 types.TipSetKey) (er
 }
 
 func (p *UnSupport) SyncMarkBad(in0 context.Context, in1 cid.Cid) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api SyncMarkBad %v", err)
 		return
@@ -955,7 +664,7 @@ func (p *UnSupport) SyncMarkBad(in0 context.Context, in1 cid.Cid) (err error) {
 }
 
 func (p *UnSupport) SyncUnmarkAllBad(in0 context.Context) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api SyncUnmarkAllBad %v", err)
 		return
@@ -964,7 +673,7 @@ func (p *UnSupport) SyncUnmarkAllBad(in0 context.Context) (err error) {
 }
 
 func (p *UnSupport) SyncUnmarkBad(in0 context.Context, in1 cid.Cid) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api SyncUnmarkBad %v", err)
 		return
@@ -972,17 +681,8 @@ func (p *UnSupport) SyncUnmarkBad(in0 context.Context, in1 cid.Cid) (err error)
 	return cli.SyncUnmarkBad(in0, in1)
 }
 
-func (p *UnSupport) SyncValidateTipset(in0 context.Context, in1 types.TipSetKey) (out0 bool, err error) {
-	cli, err := p.Select(in1)
-	if err != nil {
-		err = fmt.Errorf("api SyncValidateTipset %v", err)
-		return
-	}
-	return cli.SyncValidateTipset(in0, in1)
-}
-
 func (p *UnSupport) WalletDefaultAddress(in0 context.Context) (out0 address.Address, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api WalletDefaultAddress %v", err)
 		return
@@ -991,7 +691,7 @@ func (p *UnSupport) WalletDefaultAddress(in0 context.Context) (out0 address.Addr
 }
 
 func (p *UnSupport) WalletDelete(in0 context.Context, in1 address.Address) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api WalletDelete %v", err)
 		return
@@ -1000,7 +700,7 @@ func (p *UnSupport) WalletDelete(in0 context.Context, in1 address.Address) (err
 }
 
 func (p *UnSupport) WalletExport(in0 context.Context, in1 address.Address) (out0 *types.KeyInfo, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api WalletExport %v", err)
 		return
@@ -1009,7 +709,7 @@ func (p *UnSupport) WalletExport(in0 context.Context, in1 address.Address) (out0
 }
 
 func (p *UnSupport) WalletImport(in0 context.Context, in1 *types.KeyInfo) (out0 address.Address, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api WalletImport %v", err)
 		return
@@ -1018,7 +718,7 @@ func (p *UnSupport) WalletImport(in0 context.Context, in1 *types.KeyInfo) (out0
 }
 
 func (p *UnSupport) WalletList(in0 context.Context) (out0 []address.Address, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api WalletList %v", err)
 		return
@@ -1027,7 +727,7 @@ func (p *UnSupport) WalletList(in0 context.Context) (out0 []address.Address, err
 }
 
 func (p *UnSupport) WalletNew(in0 context.Context, in1 types.KeyType) (out0 address.Address, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api WalletNew %v", err)
 		return
@@ -1036,7 +736,7 @@ func (p *UnSupport) WalletNew(in0 context.Context, in1 types.KeyType) (out0 addr
 }
 
 func (p *UnSupport) WalletSetDefault(in0 context.Context, in1 address.Address) (err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api WalletSetDefault %v", err)
 		return
@@ -1045,7 +745,7 @@ func (p *UnSupport) WalletSetDefault(in0 context.Context, in1 address.Address) (
 }
 
 func (p *UnSupport) WalletSignMessage(in0 context.Context, in1 address.Address, in2 *types.Message) (out0 *types.SignedMessage, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api WalletSignMessage %v", err)
 		return
@@ -1053,17 +753,8 @@ func (p *UnSupport) WalletSignMessage(in0 context.Context, in1 address.Address,
 	return cli.WalletSignMessage(in0, in1, in2)
 }
 
-func (p *UnSupport) WalletValidateAddress(in0 context.Context, in1 string) (out0 address.Address, err error) {
-	cli, err := p.Select(types.EmptyTSK)
-	if err != nil {
-		err = fmt.Errorf("api WalletValidateAddress %v", err)
-		return
-	}
-	return cli.WalletValidateAddress(in0, in1)
-}
-
 func (p *UnSupport) WalletVerify(in0 context.Context, in1 address.Address, in2 []uint8, in3 *crypto.Signature) (out0 bool, err error) {
-	cli, err := p.Select(types.EmptyTSK)
+	cli, err := p.Select(in0, types.EmptyTSK)
 	if err != nil {
 		err = fmt.Errorf("api WalletVerify %v", err)
 		return
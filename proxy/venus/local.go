@@ -0,0 +1,136 @@
+package venus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	types1 "github.com/filecoin-project/venus/venus-shared/actors/types"
+	"github.com/filecoin-project/venus/venus-shared/types"
+	"github.com/ipfs-force-community/sophon-co/api/venus"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+var _ LocalAPI = (*Local)(nil)
+
+type LocalAPI interface {
+	venus.Local
+}
+
+type Local struct {
+	Select func(context.Context, types.TipSetKey) (LocalAPI, error)
+}
+
+// impl venus.Local
+func (p *Local) ChainHead(in0 context.Context) (out0 *types.TipSet, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainHead %v", err)
+		return
+	}
+	return cli.ChainHead(in0)
+}
+
+func (p *Local) ChainNotify(in0 context.Context) (out0 <-chan []*types.HeadChange, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainNotify %v", err)
+		return
+	}
+	return cli.ChainNotify(in0)
+}
+
+func (p *Local) GasBatchEstimateMessageGas(in0 context.Context, in1 []*types.EstimateMessage, in2 uint64, in3 types.TipSetKey) (out0 []*types.EstimateResult, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api GasBatchEstimateMessageGas %v", err)
+		return
+	}
+	return cli.GasBatchEstimateMessageGas(in0, in1, in2, in3)
+}
+
+func (p *Local) GasEstimateFeeCap(in0 context.Context, in1 *types1.Message, in2 int64, in3 types.TipSetKey) (out0 big.Int, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api GasEstimateFeeCap %v", err)
+		return
+	}
+	return cli.GasEstimateFeeCap(in0, in1, in2, in3)
+}
+
+func (p *Local) GasEstimateGasPremium(in0 context.Context, in1 uint64, in2 address.Address, in3 int64, in4 types.TipSetKey) (out0 big.Int, err error) {
+	cli, err := p.Select(in0, in4)
+	if err != nil {
+		err = fmt.Errorf("api GasEstimateGasPremium %v", err)
+		return
+	}
+	return cli.GasEstimateGasPremium(in0, in1, in2, in3, in4)
+}
+
+func (p *Local) GasEstimateMessageGas(in0 context.Context, in1 *types1.Message, in2 *types.MessageSendSpec, in3 types.TipSetKey) (out0 *types1.Message, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api GasEstimateMessageGas %v", err)
+		return
+	}
+	return cli.GasEstimateMessageGas(in0, in1, in2, in3)
+}
+
+func (p *Local) ID(in0 context.Context) (out0 peer.ID, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ID %v", err)
+		return
+	}
+	return cli.ID(in0)
+}
+
+func (p *Local) StartTime(in0 context.Context) (out0 time.Time, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api StartTime %v", err)
+		return
+	}
+	return cli.StartTime(in0)
+}
+
+func (p *Local) StateDecodeParams(in0 context.Context, in1 address.Address, in2 abi.MethodNum, in3 []uint8, in4 types.TipSetKey) (out0 interface{}, err error) {
+	cli, err := p.Select(in0, in4)
+	if err != nil {
+		err = fmt.Errorf("api StateDecodeParams %v", err)
+		return
+	}
+	return cli.StateDecodeParams(in0, in1, in2, in3, in4)
+}
+
+func (p *Local) StateEncodeParams(in0 context.Context, in1 cid.Cid, in2 abi.MethodNum, in3 json.RawMessage) (out0 []uint8, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api StateEncodeParams %v", err)
+		return
+	}
+	return cli.StateEncodeParams(in0, in1, in2, in3)
+}
+
+func (p *Local) StateSearchMsg(in0 context.Context, in1 types.TipSetKey, in2 cid.Cid, in3 abi.ChainEpoch, in4 bool) (out0 *types.MsgLookup, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api StateSearchMsg %v", err)
+		return
+	}
+	return cli.StateSearchMsg(in0, in1, in2, in3, in4)
+}
+
+func (p *Local) StateWaitMsg(in0 context.Context, in1 cid.Cid, in2 uint64, in3 abi.ChainEpoch, in4 bool) (out0 *types.MsgLookup, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api StateWaitMsg %v", err)
+		return
+	}
+	return cli.StateWaitMsg(in0, in1, in2, in3, in4)
+}
@@ -0,0 +1,1705 @@
+package venus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-bitfield"
+	"github.com/filecoin-project/go-f3/certs"
+	"github.com/filecoin-project/go-f3/gpbft"
+	"github.com/filecoin-project/go-f3/manifest"
+	"github.com/filecoin-project/go-jsonrpc"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/filecoin-project/go-state-types/builtin/v17/miner"
+	"github.com/filecoin-project/go-state-types/builtin/v8/paych"
+	miner1 "github.com/filecoin-project/go-state-types/builtin/v9/miner"
+	"github.com/filecoin-project/go-state-types/builtin/v9/verifreg"
+	"github.com/filecoin-project/go-state-types/crypto"
+	"github.com/filecoin-project/go-state-types/dline"
+	"github.com/filecoin-project/go-state-types/network"
+	miner2 "github.com/filecoin-project/venus/venus-shared/actors/builtin/miner"
+	types1 "github.com/filecoin-project/venus/venus-shared/actors/types"
+	"github.com/filecoin-project/venus/venus-shared/types"
+	"github.com/ipfs-force-community/sophon-co/api/venus"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+var _ ProxyAPI = (*Proxy)(nil)
+
+type ProxyAPI interface {
+	venus.Proxy
+}
+
+type Proxy struct {
+	Select func(context.Context, types.TipSetKey) (ProxyAPI, error)
+}
+
+// impl venus.Proxy
+func (p *Proxy) BlockTime(in0 context.Context) (out0 time.Duration) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api BlockTime %v", err)
+		return
+	}
+	return cli.BlockTime(in0)
+}
+
+func (p *Proxy) ChainExport(in0 context.Context, in1 abi.ChainEpoch, in2 bool, in3 types.TipSetKey) (out0 <-chan []uint8, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api ChainExport %v", err)
+		return
+	}
+	return cli.ChainExport(in0, in1, in2, in3)
+}
+
+func (p *Proxy) ChainGetBlock(in0 context.Context, in1 cid.Cid) (out0 *types.BlockHeader, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainGetBlock %v", err)
+		return
+	}
+	return cli.ChainGetBlock(in0, in1)
+}
+
+func (p *Proxy) ChainGetBlockMessages(in0 context.Context, in1 cid.Cid) (out0 *types.BlockMessages, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainGetBlockMessages %v", err)
+		return
+	}
+	return cli.ChainGetBlockMessages(in0, in1)
+}
+
+func (p *Proxy) ChainGetEvents(in0 context.Context, in1 cid.Cid) (out0 []types.Event, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainGetEvents %v", err)
+		return
+	}
+	return cli.ChainGetEvents(in0, in1)
+}
+
+func (p *Proxy) ChainGetGenesis(in0 context.Context) (out0 *types.TipSet, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainGetGenesis %v", err)
+		return
+	}
+	return cli.ChainGetGenesis(in0)
+}
+
+func (p *Proxy) ChainGetMessage(in0 context.Context, in1 cid.Cid) (out0 *types1.Message, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainGetMessage %v", err)
+		return
+	}
+	return cli.ChainGetMessage(in0, in1)
+}
+
+func (p *Proxy) ChainGetMessagesInTipset(in0 context.Context, in1 types.TipSetKey) (out0 []types.MessageCID, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api ChainGetMessagesInTipset %v", err)
+		return
+	}
+	return cli.ChainGetMessagesInTipset(in0, in1)
+}
+
+func (p *Proxy) ChainGetParentMessages(in0 context.Context, in1 cid.Cid) (out0 []types.MessageCID, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainGetParentMessages %v", err)
+		return
+	}
+	return cli.ChainGetParentMessages(in0, in1)
+}
+
+func (p *Proxy) ChainGetParentReceipts(in0 context.Context, in1 cid.Cid) (out0 []*types.MessageReceipt, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainGetParentReceipts %v", err)
+		return
+	}
+	return cli.ChainGetParentReceipts(in0, in1)
+}
+
+func (p *Proxy) ChainGetPath(in0 context.Context, in1 types.TipSetKey, in2 types.TipSetKey) (out0 []*types.HeadChange, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api ChainGetPath %v", err)
+		return
+	}
+	return cli.ChainGetPath(in0, in1, in2)
+}
+
+func (p *Proxy) ChainGetReceipts(in0 context.Context, in1 cid.Cid) (out0 []types.MessageReceipt, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainGetReceipts %v", err)
+		return
+	}
+	return cli.ChainGetReceipts(in0, in1)
+}
+
+func (p *Proxy) ChainGetTipSet(in0 context.Context, in1 types.TipSetKey) (out0 *types.TipSet, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api ChainGetTipSet %v", err)
+		return
+	}
+	return cli.ChainGetTipSet(in0, in1)
+}
+
+func (p *Proxy) ChainGetTipSetAfterHeight(in0 context.Context, in1 abi.ChainEpoch, in2 types.TipSetKey) (out0 *types.TipSet, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api ChainGetTipSetAfterHeight %v", err)
+		return
+	}
+	return cli.ChainGetTipSetAfterHeight(in0, in1, in2)
+}
+
+func (p *Proxy) ChainGetTipSetByHeight(in0 context.Context, in1 abi.ChainEpoch, in2 types.TipSetKey) (out0 *types.TipSet, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api ChainGetTipSetByHeight %v", err)
+		return
+	}
+	return cli.ChainGetTipSetByHeight(in0, in1, in2)
+}
+
+func (p *Proxy) ChainHasObj(in0 context.Context, in1 cid.Cid) (out0 bool, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainHasObj %v", err)
+		return
+	}
+	return cli.ChainHasObj(in0, in1)
+}
+
+func (p *Proxy) ChainList(in0 context.Context, in1 types.TipSetKey, in2 int) (out0 []types.TipSetKey, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api ChainList %v", err)
+		return
+	}
+	return cli.ChainList(in0, in1, in2)
+}
+
+func (p *Proxy) ChainReadObj(in0 context.Context, in1 cid.Cid) (out0 []uint8, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainReadObj %v", err)
+		return
+	}
+	return cli.ChainReadObj(in0, in1)
+}
+
+func (p *Proxy) ChainStatObj(in0 context.Context, in1 cid.Cid, in2 cid.Cid) (out0 types.ObjStat, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainStatObj %v", err)
+		return
+	}
+	return cli.ChainStatObj(in0, in1, in2)
+}
+
+func (p *Proxy) ChainTipSetWeight(in0 context.Context, in1 types.TipSetKey) (out0 big.Int, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api ChainTipSetWeight %v", err)
+		return
+	}
+	return cli.ChainTipSetWeight(in0, in1)
+}
+
+func (p *Proxy) EthAccounts(in0 context.Context) (out0 []types1.EthAddress, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthAccounts %v", err)
+		return
+	}
+	return cli.EthAccounts(in0)
+}
+
+func (p *Proxy) EthAddressToFilecoinAddress(in0 context.Context, in1 types1.EthAddress) (out0 address.Address, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthAddressToFilecoinAddress %v", err)
+		return
+	}
+	return cli.EthAddressToFilecoinAddress(in0, in1)
+}
+
+func (p *Proxy) EthBlockNumber(in0 context.Context) (out0 types1.EthUint64, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthBlockNumber %v", err)
+		return
+	}
+	return cli.EthBlockNumber(in0)
+}
+
+func (p *Proxy) EthCall(in0 context.Context, in1 types1.EthCall, in2 types1.EthBlockNumberOrHash) (out0 types1.EthBytes, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthCall %v", err)
+		return
+	}
+	return cli.EthCall(in0, in1, in2)
+}
+
+func (p *Proxy) EthChainId(in0 context.Context) (out0 types1.EthUint64, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthChainId %v", err)
+		return
+	}
+	return cli.EthChainId(in0)
+}
+
+func (p *Proxy) EthEstimateGas(in0 context.Context, in1 jsonrpc.RawParams) (out0 types1.EthUint64, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthEstimateGas %v", err)
+		return
+	}
+	return cli.EthEstimateGas(in0, in1)
+}
+
+func (p *Proxy) EthFeeHistory(in0 context.Context, in1 jsonrpc.RawParams) (out0 types1.EthFeeHistory, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthFeeHistory %v", err)
+		return
+	}
+	return cli.EthFeeHistory(in0, in1)
+}
+
+func (p *Proxy) EthGasPrice(in0 context.Context) (out0 types1.EthBigInt, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGasPrice %v", err)
+		return
+	}
+	return cli.EthGasPrice(in0)
+}
+
+func (p *Proxy) EthGetBalance(in0 context.Context, in1 types1.EthAddress, in2 types1.EthBlockNumberOrHash) (out0 types1.EthBigInt, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetBalance %v", err)
+		return
+	}
+	return cli.EthGetBalance(in0, in1, in2)
+}
+
+func (p *Proxy) EthGetBlockByHash(in0 context.Context, in1 types1.EthHash, in2 bool) (out0 types1.EthBlock, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetBlockByHash %v", err)
+		return
+	}
+	return cli.EthGetBlockByHash(in0, in1, in2)
+}
+
+func (p *Proxy) EthGetBlockByNumber(in0 context.Context, in1 string, in2 bool) (out0 types1.EthBlock, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetBlockByNumber %v", err)
+		return
+	}
+	return cli.EthGetBlockByNumber(in0, in1, in2)
+}
+
+func (p *Proxy) EthGetBlockReceipts(in0 context.Context, in1 types1.EthBlockNumberOrHash) (out0 []*types1.EthTxReceipt, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetBlockReceipts %v", err)
+		return
+	}
+	return cli.EthGetBlockReceipts(in0, in1)
+}
+
+func (p *Proxy) EthGetBlockReceiptsLimited(in0 context.Context, in1 types1.EthBlockNumberOrHash, in2 abi.ChainEpoch) (out0 []*types1.EthTxReceipt, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetBlockReceiptsLimited %v", err)
+		return
+	}
+	return cli.EthGetBlockReceiptsLimited(in0, in1, in2)
+}
+
+func (p *Proxy) EthGetBlockTransactionCountByHash(in0 context.Context, in1 types1.EthHash) (out0 types1.EthUint64, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetBlockTransactionCountByHash %v", err)
+		return
+	}
+	return cli.EthGetBlockTransactionCountByHash(in0, in1)
+}
+
+func (p *Proxy) EthGetBlockTransactionCountByNumber(in0 context.Context, in1 types1.EthUint64) (out0 types1.EthUint64, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetBlockTransactionCountByNumber %v", err)
+		return
+	}
+	return cli.EthGetBlockTransactionCountByNumber(in0, in1)
+}
+
+func (p *Proxy) EthGetCode(in0 context.Context, in1 types1.EthAddress, in2 types1.EthBlockNumberOrHash) (out0 types1.EthBytes, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetCode %v", err)
+		return
+	}
+	return cli.EthGetCode(in0, in1, in2)
+}
+
+func (p *Proxy) EthGetFilterChanges(in0 context.Context, in1 types1.EthFilterID) (out0 *types1.EthFilterResult, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetFilterChanges %v", err)
+		return
+	}
+	return cli.EthGetFilterChanges(in0, in1)
+}
+
+func (p *Proxy) EthGetFilterLogs(in0 context.Context, in1 types1.EthFilterID) (out0 *types1.EthFilterResult, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetFilterLogs %v", err)
+		return
+	}
+	return cli.EthGetFilterLogs(in0, in1)
+}
+
+func (p *Proxy) EthGetLogs(in0 context.Context, in1 *types1.EthFilterSpec) (out0 *types1.EthFilterResult, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetLogs %v", err)
+		return
+	}
+	return cli.EthGetLogs(in0, in1)
+}
+
+func (p *Proxy) EthGetMessageCidByTransactionHash(in0 context.Context, in1 *types1.EthHash) (out0 *cid.Cid, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetMessageCidByTransactionHash %v", err)
+		return
+	}
+	return cli.EthGetMessageCidByTransactionHash(in0, in1)
+}
+
+func (p *Proxy) EthGetStorageAt(in0 context.Context, in1 types1.EthAddress, in2 types1.EthBytes, in3 types1.EthBlockNumberOrHash) (out0 types1.EthBytes, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetStorageAt %v", err)
+		return
+	}
+	return cli.EthGetStorageAt(in0, in1, in2, in3)
+}
+
+func (p *Proxy) EthGetTransactionByBlockHashAndIndex(in0 context.Context, in1 types1.EthHash, in2 types1.EthUint64) (out0 types1.EthTx, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetTransactionByBlockHashAndIndex %v", err)
+		return
+	}
+	return cli.EthGetTransactionByBlockHashAndIndex(in0, in1, in2)
+}
+
+func (p *Proxy) EthGetTransactionByBlockNumberAndIndex(in0 context.Context, in1 types1.EthUint64, in2 types1.EthUint64) (out0 types1.EthTx, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetTransactionByBlockNumberAndIndex %v", err)
+		return
+	}
+	return cli.EthGetTransactionByBlockNumberAndIndex(in0, in1, in2)
+}
+
+func (p *Proxy) EthGetTransactionByHash(in0 context.Context, in1 *types1.EthHash) (out0 *types1.EthTx, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetTransactionByHash %v", err)
+		return
+	}
+	return cli.EthGetTransactionByHash(in0, in1)
+}
+
+func (p *Proxy) EthGetTransactionByHashLimited(in0 context.Context, in1 *types1.EthHash, in2 abi.ChainEpoch) (out0 *types1.EthTx, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetTransactionByHashLimited %v", err)
+		return
+	}
+	return cli.EthGetTransactionByHashLimited(in0, in1, in2)
+}
+
+func (p *Proxy) EthGetTransactionCount(in0 context.Context, in1 types1.EthAddress, in2 types1.EthBlockNumberOrHash) (out0 types1.EthUint64, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetTransactionCount %v", err)
+		return
+	}
+	return cli.EthGetTransactionCount(in0, in1, in2)
+}
+
+func (p *Proxy) EthGetTransactionHashByCid(in0 context.Context, in1 cid.Cid) (out0 *types1.EthHash, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetTransactionHashByCid %v", err)
+		return
+	}
+	return cli.EthGetTransactionHashByCid(in0, in1)
+}
+
+func (p *Proxy) EthGetTransactionReceipt(in0 context.Context, in1 types1.EthHash) (out0 *types1.EthTxReceipt, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetTransactionReceipt %v", err)
+		return
+	}
+	return cli.EthGetTransactionReceipt(in0, in1)
+}
+
+func (p *Proxy) EthGetTransactionReceiptLimited(in0 context.Context, in1 types1.EthHash, in2 abi.ChainEpoch) (out0 *types1.EthTxReceipt, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthGetTransactionReceiptLimited %v", err)
+		return
+	}
+	return cli.EthGetTransactionReceiptLimited(in0, in1, in2)
+}
+
+func (p *Proxy) EthMaxPriorityFeePerGas(in0 context.Context) (out0 types1.EthBigInt, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthMaxPriorityFeePerGas %v", err)
+		return
+	}
+	return cli.EthMaxPriorityFeePerGas(in0)
+}
+
+func (p *Proxy) EthNewBlockFilter(in0 context.Context) (out0 types1.EthFilterID, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthNewBlockFilter %v", err)
+		return
+	}
+	return cli.EthNewBlockFilter(in0)
+}
+
+func (p *Proxy) EthNewFilter(in0 context.Context, in1 *types1.EthFilterSpec) (out0 types1.EthFilterID, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthNewFilter %v", err)
+		return
+	}
+	return cli.EthNewFilter(in0, in1)
+}
+
+func (p *Proxy) EthNewPendingTransactionFilter(in0 context.Context) (out0 types1.EthFilterID, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthNewPendingTransactionFilter %v", err)
+		return
+	}
+	return cli.EthNewPendingTransactionFilter(in0)
+}
+
+func (p *Proxy) EthProtocolVersion(in0 context.Context) (out0 types1.EthUint64, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthProtocolVersion %v", err)
+		return
+	}
+	return cli.EthProtocolVersion(in0)
+}
+
+func (p *Proxy) EthSendRawTransaction(in0 context.Context, in1 types1.EthBytes) (out0 types1.EthHash, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthSendRawTransaction %v", err)
+		return
+	}
+	return cli.EthSendRawTransaction(in0, in1)
+}
+
+func (p *Proxy) EthSubscribe(in0 context.Context, in1 jsonrpc.RawParams) (out0 types1.EthSubscriptionID, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthSubscribe %v", err)
+		return
+	}
+	return cli.EthSubscribe(in0, in1)
+}
+
+func (p *Proxy) EthSyncing(in0 context.Context) (out0 types1.EthSyncingResult, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthSyncing %v", err)
+		return
+	}
+	return cli.EthSyncing(in0)
+}
+
+func (p *Proxy) EthTraceBlock(in0 context.Context, in1 string) (out0 []*types1.EthTraceBlock, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthTraceBlock %v", err)
+		return
+	}
+	return cli.EthTraceBlock(in0, in1)
+}
+
+func (p *Proxy) EthTraceFilter(in0 context.Context, in1 types1.EthTraceFilterCriteria) (out0 []*types1.EthTraceFilterResult, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthTraceFilter %v", err)
+		return
+	}
+	return cli.EthTraceFilter(in0, in1)
+}
+
+func (p *Proxy) EthTraceReplayBlockTransactions(in0 context.Context, in1 string, in2 []string) (out0 []*types1.EthTraceReplayBlockTransaction, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthTraceReplayBlockTransactions %v", err)
+		return
+	}
+	return cli.EthTraceReplayBlockTransactions(in0, in1, in2)
+}
+
+func (p *Proxy) EthTraceTransaction(in0 context.Context, in1 string) (out0 []*types1.EthTraceTransaction, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthTraceTransaction %v", err)
+		return
+	}
+	return cli.EthTraceTransaction(in0, in1)
+}
+
+func (p *Proxy) EthUninstallFilter(in0 context.Context, in1 types1.EthFilterID) (out0 bool, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthUninstallFilter %v", err)
+		return
+	}
+	return cli.EthUninstallFilter(in0, in1)
+}
+
+func (p *Proxy) EthUnsubscribe(in0 context.Context, in1 types1.EthSubscriptionID) (out0 bool, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api EthUnsubscribe %v", err)
+		return
+	}
+	return cli.EthUnsubscribe(in0, in1)
+}
+
+func (p *Proxy) F3GetCertificate(in0 context.Context, in1 uint64) (out0 *certs.FinalityCertificate, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api F3GetCertificate %v", err)
+		return
+	}
+	return cli.F3GetCertificate(in0, in1)
+}
+
+func (p *Proxy) F3GetECPowerTable(in0 context.Context, in1 types.TipSetKey) (out0 gpbft.PowerEntries, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api F3GetECPowerTable %v", err)
+		return
+	}
+	return cli.F3GetECPowerTable(in0, in1)
+}
+
+func (p *Proxy) F3GetF3PowerTable(in0 context.Context, in1 types.TipSetKey) (out0 gpbft.PowerEntries, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api F3GetF3PowerTable %v", err)
+		return
+	}
+	return cli.F3GetF3PowerTable(in0, in1)
+}
+
+func (p *Proxy) F3GetLatestCertificate(in0 context.Context) (out0 *certs.FinalityCertificate, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api F3GetLatestCertificate %v", err)
+		return
+	}
+	return cli.F3GetLatestCertificate(in0)
+}
+
+func (p *Proxy) F3GetManifest(in0 context.Context) (out0 *manifest.Manifest, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api F3GetManifest %v", err)
+		return
+	}
+	return cli.F3GetManifest(in0)
+}
+
+func (p *Proxy) F3GetOrRenewParticipationTicket(in0 context.Context, in1 address.Address, in2 types.F3ParticipationTicket, in3 uint64) (out0 types.F3ParticipationTicket, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api F3GetOrRenewParticipationTicket %v", err)
+		return
+	}
+	return cli.F3GetOrRenewParticipationTicket(in0, in1, in2, in3)
+}
+
+func (p *Proxy) F3GetPowerTableByInstance(in0 context.Context, in1 uint64) (out0 gpbft.PowerEntries, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api F3GetPowerTableByInstance %v", err)
+		return
+	}
+	return cli.F3GetPowerTableByInstance(in0, in1)
+}
+
+func (p *Proxy) F3GetProgress(in0 context.Context) (out0 gpbft.InstanceProgress, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api F3GetProgress %v", err)
+		return
+	}
+	return cli.F3GetProgress(in0)
+}
+
+func (p *Proxy) F3IsRunning(in0 context.Context) (out0 bool, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api F3IsRunning %v", err)
+		return
+	}
+	return cli.F3IsRunning(in0)
+}
+
+func (p *Proxy) F3ListParticipants(in0 context.Context) (out0 []types.F3Participant, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api F3ListParticipants %v", err)
+		return
+	}
+	return cli.F3ListParticipants(in0)
+}
+
+func (p *Proxy) F3Participate(in0 context.Context, in1 types.F3ParticipationTicket) (out0 types.F3ParticipationLease, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api F3Participate %v", err)
+		return
+	}
+	return cli.F3Participate(in0, in1)
+}
+
+func (p *Proxy) FilecoinAddressToEthAddress(in0 context.Context, in1 address.Address) (out0 types1.EthAddress, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api FilecoinAddressToEthAddress %v", err)
+		return
+	}
+	return cli.FilecoinAddressToEthAddress(in0, in1)
+}
+
+func (p *Proxy) GasEstimateGasLimit(in0 context.Context, in1 *types1.Message, in2 types.TipSetKey) (out0 int64, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api GasEstimateGasLimit %v", err)
+		return
+	}
+	return cli.GasEstimateGasLimit(in0, in1, in2)
+}
+
+func (p *Proxy) GetActor(in0 context.Context, in1 address.Address) (out0 *types1.ActorV5, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api GetActor %v", err)
+		return
+	}
+	return cli.GetActor(in0, in1)
+}
+
+func (p *Proxy) GetActorEventsRaw(in0 context.Context, in1 *types.ActorEventFilter) (out0 []*types.ActorEvent, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api GetActorEventsRaw %v", err)
+		return
+	}
+	return cli.GetActorEventsRaw(in0, in1)
+}
+
+func (p *Proxy) GetEntry(in0 context.Context, in1 abi.ChainEpoch, in2 uint64) (out0 *types.BeaconEntry, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api GetEntry %v", err)
+		return
+	}
+	return cli.GetEntry(in0, in1, in2)
+}
+
+func (p *Proxy) GetFullBlock(in0 context.Context, in1 cid.Cid) (out0 *types.FullBlock, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api GetFullBlock %v", err)
+		return
+	}
+	return cli.GetFullBlock(in0, in1)
+}
+
+func (p *Proxy) GetParentStateRootActor(in0 context.Context, in1 *types.TipSet, in2 address.Address) (out0 *types1.ActorV5, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api GetParentStateRootActor %v", err)
+		return
+	}
+	return cli.GetParentStateRootActor(in0, in1, in2)
+}
+
+func (p *Proxy) ListActor(in0 context.Context) (out0 map[address.Address]*types1.ActorV5, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ListActor %v", err)
+		return
+	}
+	return cli.ListActor(in0)
+}
+
+func (p *Proxy) MinerCreateBlock(in0 context.Context, in1 *types.BlockTemplate) (out0 *types.BlockMsg, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MinerCreateBlock %v", err)
+		return
+	}
+	return cli.MinerCreateBlock(in0, in1)
+}
+
+func (p *Proxy) MinerGetBaseInfo(in0 context.Context, in1 address.Address, in2 abi.ChainEpoch, in3 types.TipSetKey) (out0 *types.MiningBaseInfo, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api MinerGetBaseInfo %v", err)
+		return
+	}
+	return cli.MinerGetBaseInfo(in0, in1, in2, in3)
+}
+
+func (p *Proxy) MpoolBatchPush(in0 context.Context, in1 []*types1.SignedMessage) (out0 []cid.Cid, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolBatchPush %v", err)
+		return
+	}
+	return cli.MpoolBatchPush(in0, in1)
+}
+
+func (p *Proxy) MpoolBatchPushUntrusted(in0 context.Context, in1 []*types1.SignedMessage) (out0 []cid.Cid, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolBatchPushUntrusted %v", err)
+		return
+	}
+	return cli.MpoolBatchPushUntrusted(in0, in1)
+}
+
+func (p *Proxy) MpoolCheckMessages(in0 context.Context, in1 []*types.MessagePrototype) (out0 [][]types.MessageCheckStatus, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolCheckMessages %v", err)
+		return
+	}
+	return cli.MpoolCheckMessages(in0, in1)
+}
+
+func (p *Proxy) MpoolCheckPendingMessages(in0 context.Context, in1 address.Address) (out0 [][]types.MessageCheckStatus, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolCheckPendingMessages %v", err)
+		return
+	}
+	return cli.MpoolCheckPendingMessages(in0, in1)
+}
+
+func (p *Proxy) MpoolCheckReplaceMessages(in0 context.Context, in1 []*types1.Message) (out0 [][]types.MessageCheckStatus, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolCheckReplaceMessages %v", err)
+		return
+	}
+	return cli.MpoolCheckReplaceMessages(in0, in1)
+}
+
+func (p *Proxy) MpoolGetConfig(in0 context.Context) (out0 *types.MpoolConfig, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolGetConfig %v", err)
+		return
+	}
+	return cli.MpoolGetConfig(in0)
+}
+
+func (p *Proxy) MpoolGetNonce(in0 context.Context, in1 address.Address) (out0 uint64, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolGetNonce %v", err)
+		return
+	}
+	return cli.MpoolGetNonce(in0, in1)
+}
+
+func (p *Proxy) MpoolPending(in0 context.Context, in1 types.TipSetKey) (out0 []*types1.SignedMessage, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api MpoolPending %v", err)
+		return
+	}
+	return cli.MpoolPending(in0, in1)
+}
+
+func (p *Proxy) MpoolPublishByAddr(in0 context.Context, in1 address.Address) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolPublishByAddr %v", err)
+		return
+	}
+	return cli.MpoolPublishByAddr(in0, in1)
+}
+
+func (p *Proxy) MpoolPublishMessage(in0 context.Context, in1 *types1.SignedMessage) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolPublishMessage %v", err)
+		return
+	}
+	return cli.MpoolPublishMessage(in0, in1)
+}
+
+func (p *Proxy) MpoolPush(in0 context.Context, in1 *types1.SignedMessage) (out0 cid.Cid, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolPush %v", err)
+		return
+	}
+	return cli.MpoolPush(in0, in1)
+}
+
+func (p *Proxy) MpoolPushMessage(in0 context.Context, in1 *types1.Message, in2 *types.MessageSendSpec) (out0 *types1.SignedMessage, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolPushMessage %v", err)
+		return
+	}
+	return cli.MpoolPushMessage(in0, in1, in2)
+}
+
+func (p *Proxy) MpoolSelect(in0 context.Context, in1 types.TipSetKey, in2 float64) (out0 []*types1.SignedMessage, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api MpoolSelect %v", err)
+		return
+	}
+	return cli.MpoolSelect(in0, in1, in2)
+}
+
+func (p *Proxy) MpoolSelects(in0 context.Context, in1 types.TipSetKey, in2 []float64) (out0 [][]*types1.SignedMessage, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api MpoolSelects %v", err)
+		return
+	}
+	return cli.MpoolSelects(in0, in1, in2)
+}
+
+func (p *Proxy) MpoolSub(in0 context.Context) (out0 <-chan types.MpoolUpdate, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolSub %v", err)
+		return
+	}
+	return cli.MpoolSub(in0)
+}
+
+func (p *Proxy) NetAddrsListen(in0 context.Context) (out0 peer.AddrInfo, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetAddrsListen %v", err)
+		return
+	}
+	return cli.NetAddrsListen(in0)
+}
+
+func (p *Proxy) NetListening(in0 context.Context) (out0 bool, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetListening %v", err)
+		return
+	}
+	return cli.NetListening(in0)
+}
+
+func (p *Proxy) NetProtectAdd(in0 context.Context, in1 []peer.ID) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetProtectAdd %v", err)
+		return
+	}
+	return cli.NetProtectAdd(in0, in1)
+}
+
+func (p *Proxy) NetVersion(in0 context.Context) (out0 string, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetVersion %v", err)
+		return
+	}
+	return cli.NetVersion(in0)
+}
+
+func (p *Proxy) NodeStatus(in0 context.Context, in1 bool) (out0 types.NodeStatus, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NodeStatus %v", err)
+		return
+	}
+	return cli.NodeStatus(in0, in1)
+}
+
+func (p *Proxy) PaychList(in0 context.Context) (out0 []address.Address, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychList %v", err)
+		return
+	}
+	return cli.PaychList(in0)
+}
+
+func (p *Proxy) PaychStatus(in0 context.Context, in1 address.Address) (out0 *types.Status, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychStatus %v", err)
+		return
+	}
+	return cli.PaychStatus(in0, in1)
+}
+
+func (p *Proxy) PaychVoucherCheckSpendable(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher, in3 []uint8, in4 []uint8) (out0 bool, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychVoucherCheckSpendable %v", err)
+		return
+	}
+	return cli.PaychVoucherCheckSpendable(in0, in1, in2, in3, in4)
+}
+
+func (p *Proxy) PaychVoucherCheckValid(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychVoucherCheckValid %v", err)
+		return
+	}
+	return cli.PaychVoucherCheckValid(in0, in1, in2)
+}
+
+func (p *Proxy) ProtocolParameters(in0 context.Context) (out0 *types.ProtocolParams, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ProtocolParameters %v", err)
+		return
+	}
+	return cli.ProtocolParameters(in0)
+}
+
+func (p *Proxy) ResolveToKeyAddr(in0 context.Context, in1 address.Address, in2 *types.TipSet) (out0 address.Address, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ResolveToKeyAddr %v", err)
+		return
+	}
+	return cli.ResolveToKeyAddr(in0, in1, in2)
+}
+
+func (p *Proxy) StateAccountKey(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 address.Address, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateAccountKey %v", err)
+		return
+	}
+	return cli.StateAccountKey(in0, in1, in2)
+}
+
+func (p *Proxy) StateActorCodeCIDs(in0 context.Context, in1 network.Version) (out0 map[string]cid.Cid, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api StateActorCodeCIDs %v", err)
+		return
+	}
+	return cli.StateActorCodeCIDs(in0, in1)
+}
+
+func (p *Proxy) StateActorManifestCID(in0 context.Context, in1 network.Version) (out0 cid.Cid, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api StateActorManifestCID %v", err)
+		return
+	}
+	return cli.StateActorManifestCID(in0, in1)
+}
+
+func (p *Proxy) StateAllMinerFaults(in0 context.Context, in1 abi.ChainEpoch, in2 types.TipSetKey) (out0 []*types.Fault, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateAllMinerFaults %v", err)
+		return
+	}
+	return cli.StateAllMinerFaults(in0, in1, in2)
+}
+
+func (p *Proxy) StateCall(in0 context.Context, in1 *types1.Message, in2 types.TipSetKey) (out0 *types.InvocResult, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateCall %v", err)
+		return
+	}
+	return cli.StateCall(in0, in1, in2)
+}
+
+func (p *Proxy) StateChangedActors(in0 context.Context, in1 cid.Cid, in2 cid.Cid) (out0 map[string]types1.ActorV5, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api StateChangedActors %v", err)
+		return
+	}
+	return cli.StateChangedActors(in0, in1, in2)
+}
+
+func (p *Proxy) StateCirculatingSupply(in0 context.Context, in1 types.TipSetKey) (out0 big.Int, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api StateCirculatingSupply %v", err)
+		return
+	}
+	return cli.StateCirculatingSupply(in0, in1)
+}
+
+func (p *Proxy) StateCompute(in0 context.Context, in1 abi.ChainEpoch, in2 []*types1.Message, in3 types.TipSetKey) (out0 *types.ComputeStateOutput, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api StateCompute %v", err)
+		return
+	}
+	return cli.StateCompute(in0, in1, in2, in3)
+}
+
+func (p *Proxy) StateComputeDataCID(in0 context.Context, in1 address.Address, in2 abi.RegisteredSealProof, in3 []abi.DealID, in4 types.TipSetKey) (out0 cid.Cid, err error) {
+	cli, err := p.Select(in0, in4)
+	if err != nil {
+		err = fmt.Errorf("api StateComputeDataCID %v", err)
+		return
+	}
+	return cli.StateComputeDataCID(in0, in1, in2, in3, in4)
+}
+
+func (p *Proxy) StateDealProviderCollateralBounds(in0 context.Context, in1 abi.PaddedPieceSize, in2 bool, in3 types.TipSetKey) (out0 types.DealCollateralBounds, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api StateDealProviderCollateralBounds %v", err)
+		return
+	}
+	return cli.StateDealProviderCollateralBounds(in0, in1, in2, in3)
+}
+
+func (p *Proxy) StateGetActor(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *types1.ActorV5, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateGetActor %v", err)
+		return
+	}
+	return cli.StateGetActor(in0, in1, in2)
+}
+
+func (p *Proxy) StateGetAllAllocations(in0 context.Context, in1 types.TipSetKey) (out0 map[verifreg.AllocationId]verifreg.Allocation, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api StateGetAllAllocations %v", err)
+		return
+	}
+	return cli.StateGetAllAllocations(in0, in1)
+}
+
+func (p *Proxy) StateGetAllClaims(in0 context.Context, in1 types.TipSetKey) (out0 map[verifreg.ClaimId]verifreg.Claim, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api StateGetAllClaims %v", err)
+		return
+	}
+	return cli.StateGetAllClaims(in0, in1)
+}
+
+func (p *Proxy) StateGetAllocation(in0 context.Context, in1 address.Address, in2 verifreg.AllocationId, in3 types.TipSetKey) (out0 *verifreg.Allocation, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api StateGetAllocation %v", err)
+		return
+	}
+	return cli.StateGetAllocation(in0, in1, in2, in3)
+}
+
+func (p *Proxy) StateGetAllocationForPendingDeal(in0 context.Context, in1 abi.DealID, in2 types.TipSetKey) (out0 *verifreg.Allocation, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateGetAllocationForPendingDeal %v", err)
+		return
+	}
+	return cli.StateGetAllocationForPendingDeal(in0, in1, in2)
+}
+
+func (p *Proxy) StateGetAllocationIdForPendingDeal(in0 context.Context, in1 abi.DealID, in2 types.TipSetKey) (out0 verifreg.AllocationId, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateGetAllocationIdForPendingDeal %v", err)
+		return
+	}
+	return cli.StateGetAllocationIdForPendingDeal(in0, in1, in2)
+}
+
+func (p *Proxy) StateGetAllocations(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 map[verifreg.AllocationId]verifreg.Allocation, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateGetAllocations %v", err)
+		return
+	}
+	return cli.StateGetAllocations(in0, in1, in2)
+}
+
+func (p *Proxy) StateGetBeaconEntry(in0 context.Context, in1 abi.ChainEpoch) (out0 *types.BeaconEntry, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api StateGetBeaconEntry %v", err)
+		return
+	}
+	return cli.StateGetBeaconEntry(in0, in1)
+}
+
+func (p *Proxy) StateGetClaim(in0 context.Context, in1 address.Address, in2 verifreg.ClaimId, in3 types.TipSetKey) (out0 *verifreg.Claim, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api StateGetClaim %v", err)
+		return
+	}
+	return cli.StateGetClaim(in0, in1, in2, in3)
+}
+
+func (p *Proxy) StateGetClaims(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 map[verifreg.ClaimId]verifreg.Claim, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateGetClaims %v", err)
+		return
+	}
+	return cli.StateGetClaims(in0, in1, in2)
+}
+
+func (p *Proxy) StateGetNetworkParams(in0 context.Context) (out0 *types.NetworkParams, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api StateGetNetworkParams %v", err)
+		return
+	}
+	return cli.StateGetNetworkParams(in0)
+}
+
+func (p *Proxy) StateGetRandomnessDigestFromBeacon(in0 context.Context, in1 abi.ChainEpoch, in2 types.TipSetKey) (out0 abi.Randomness, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateGetRandomnessDigestFromBeacon %v", err)
+		return
+	}
+	return cli.StateGetRandomnessDigestFromBeacon(in0, in1, in2)
+}
+
+func (p *Proxy) StateGetRandomnessDigestFromTickets(in0 context.Context, in1 abi.ChainEpoch, in2 types.TipSetKey) (out0 abi.Randomness, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateGetRandomnessDigestFromTickets %v", err)
+		return
+	}
+	return cli.StateGetRandomnessDigestFromTickets(in0, in1, in2)
+}
+
+func (p *Proxy) StateGetRandomnessFromBeacon(in0 context.Context, in1 crypto.DomainSeparationTag, in2 abi.ChainEpoch, in3 []uint8, in4 types.TipSetKey) (out0 abi.Randomness, err error) {
+	cli, err := p.Select(in0, in4)
+	if err != nil {
+		err = fmt.Errorf("api StateGetRandomnessFromBeacon %v", err)
+		return
+	}
+	return cli.StateGetRandomnessFromBeacon(in0, in1, in2, in3, in4)
+}
+
+func (p *Proxy) StateGetRandomnessFromTickets(in0 context.Context, in1 crypto.DomainSeparationTag, in2 abi.ChainEpoch, in3 []uint8, in4 types.TipSetKey) (out0 abi.Randomness, err error) {
+	cli, err := p.Select(in0, in4)
+	if err != nil {
+		err = fmt.Errorf("api StateGetRandomnessFromTickets %v", err)
+		return
+	}
+	return cli.StateGetRandomnessFromTickets(in0, in1, in2, in3, in4)
+}
+
+func (p *Proxy) StateListActors(in0 context.Context, in1 types.TipSetKey) (out0 []address.Address, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api StateListActors %v", err)
+		return
+	}
+	return cli.StateListActors(in0, in1)
+}
+
+func (p *Proxy) StateListMessages(in0 context.Context, in1 *types.MessageMatch, in2 types.TipSetKey, in3 abi.ChainEpoch) (out0 []cid.Cid, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateListMessages %v", err)
+		return
+	}
+	return cli.StateListMessages(in0, in1, in2, in3)
+}
+
+func (p *Proxy) StateListMiners(in0 context.Context, in1 types.TipSetKey) (out0 []address.Address, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api StateListMiners %v", err)
+		return
+	}
+	return cli.StateListMiners(in0, in1)
+}
+
+func (p *Proxy) StateLookupID(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 address.Address, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateLookupID %v", err)
+		return
+	}
+	return cli.StateLookupID(in0, in1, in2)
+}
+
+func (p *Proxy) StateLookupRobustAddress(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 address.Address, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateLookupRobustAddress %v", err)
+		return
+	}
+	return cli.StateLookupRobustAddress(in0, in1, in2)
+}
+
+func (p *Proxy) StateMarketBalance(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 types.MarketBalance, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateMarketBalance %v", err)
+		return
+	}
+	return cli.StateMarketBalance(in0, in1, in2)
+}
+
+func (p *Proxy) StateMarketDeals(in0 context.Context, in1 types.TipSetKey) (out0 map[string]*types.MarketDeal, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api StateMarketDeals %v", err)
+		return
+	}
+	return cli.StateMarketDeals(in0, in1)
+}
+
+func (p *Proxy) StateMarketParticipants(in0 context.Context, in1 types.TipSetKey) (out0 map[string]types.MarketBalance, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api StateMarketParticipants %v", err)
+		return
+	}
+	return cli.StateMarketParticipants(in0, in1)
+}
+
+func (p *Proxy) StateMarketProposalPending(in0 context.Context, in1 cid.Cid, in2 types.TipSetKey) (out0 bool, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateMarketProposalPending %v", err)
+		return
+	}
+	return cli.StateMarketProposalPending(in0, in1, in2)
+}
+
+func (p *Proxy) StateMarketStorageDeal(in0 context.Context, in1 abi.DealID, in2 types.TipSetKey) (out0 *types.MarketDeal, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateMarketStorageDeal %v", err)
+		return
+	}
+	return cli.StateMarketStorageDeal(in0, in1, in2)
+}
+
+func (p *Proxy) StateMinerActiveSectors(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 []*miner.SectorOnChainInfo, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerActiveSectors %v", err)
+		return
+	}
+	return cli.StateMinerActiveSectors(in0, in1, in2)
+}
+
+func (p *Proxy) StateMinerAllocated(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *bitfield.BitField, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerAllocated %v", err)
+		return
+	}
+	return cli.StateMinerAllocated(in0, in1, in2)
+}
+
+func (p *Proxy) StateMinerAvailableBalance(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 big.Int, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerAvailableBalance %v", err)
+		return
+	}
+	return cli.StateMinerAvailableBalance(in0, in1, in2)
+}
+
+func (p *Proxy) StateMinerCreationDeposit(in0 context.Context, in1 types.TipSetKey) (out0 big.Int, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerCreationDeposit %v", err)
+		return
+	}
+	return cli.StateMinerCreationDeposit(in0, in1)
+}
+
+func (p *Proxy) StateMinerDeadlines(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 []types.Deadline, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerDeadlines %v", err)
+		return
+	}
+	return cli.StateMinerDeadlines(in0, in1, in2)
+}
+
+func (p *Proxy) StateMinerFaults(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 bitfield.BitField, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerFaults %v", err)
+		return
+	}
+	return cli.StateMinerFaults(in0, in1, in2)
+}
+
+func (p *Proxy) StateMinerInfo(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 types.MinerInfo, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerInfo %v", err)
+		return
+	}
+	return cli.StateMinerInfo(in0, in1, in2)
+}
+
+func (p *Proxy) StateMinerInitialPledgeCollateral(in0 context.Context, in1 address.Address, in2 miner1.SectorPreCommitInfo, in3 types.TipSetKey) (out0 big.Int, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerInitialPledgeCollateral %v", err)
+		return
+	}
+	return cli.StateMinerInitialPledgeCollateral(in0, in1, in2, in3)
+}
+
+func (p *Proxy) StateMinerInitialPledgeForSector(in0 context.Context, in1 abi.ChainEpoch, in2 abi.SectorSize, in3 uint64, in4 types.TipSetKey) (out0 big.Int, err error) {
+	cli, err := p.Select(in0, in4)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerInitialPledgeForSector %v", err)
+		return
+	}
+	return cli.StateMinerInitialPledgeForSector(in0, in1, in2, in3, in4)
+}
+
+func (p *Proxy) StateMinerPartitions(in0 context.Context, in1 address.Address, in2 uint64, in3 types.TipSetKey) (out0 []types.Partition, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerPartitions %v", err)
+		return
+	}
+	return cli.StateMinerPartitions(in0, in1, in2, in3)
+}
+
+func (p *Proxy) StateMinerPower(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *types.MinerPower, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerPower %v", err)
+		return
+	}
+	return cli.StateMinerPower(in0, in1, in2)
+}
+
+func (p *Proxy) StateMinerPreCommitDepositForPower(in0 context.Context, in1 address.Address, in2 miner1.SectorPreCommitInfo, in3 types.TipSetKey) (out0 big.Int, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerPreCommitDepositForPower %v", err)
+		return
+	}
+	return cli.StateMinerPreCommitDepositForPower(in0, in1, in2, in3)
+}
+
+func (p *Proxy) StateMinerProvingDeadline(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *dline.Info, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerProvingDeadline %v", err)
+		return
+	}
+	return cli.StateMinerProvingDeadline(in0, in1, in2)
+}
+
+func (p *Proxy) StateMinerRecoveries(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 bitfield.BitField, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerRecoveries %v", err)
+		return
+	}
+	return cli.StateMinerRecoveries(in0, in1, in2)
+}
+
+func (p *Proxy) StateMinerSectorAllocated(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 bool, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerSectorAllocated %v", err)
+		return
+	}
+	return cli.StateMinerSectorAllocated(in0, in1, in2, in3)
+}
+
+func (p *Proxy) StateMinerSectorCount(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 types.MinerSectors, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerSectorCount %v", err)
+		return
+	}
+	return cli.StateMinerSectorCount(in0, in1, in2)
+}
+
+func (p *Proxy) StateMinerSectorSize(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 abi.SectorSize, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerSectorSize %v", err)
+		return
+	}
+	return cli.StateMinerSectorSize(in0, in1, in2)
+}
+
+func (p *Proxy) StateMinerSectors(in0 context.Context, in1 address.Address, in2 *bitfield.BitField, in3 types.TipSetKey) (out0 []*miner.SectorOnChainInfo, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerSectors %v", err)
+		return
+	}
+	return cli.StateMinerSectors(in0, in1, in2, in3)
+}
+
+func (p *Proxy) StateMinerWorkerAddress(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 address.Address, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateMinerWorkerAddress %v", err)
+		return
+	}
+	return cli.StateMinerWorkerAddress(in0, in1, in2)
+}
+
+func (p *Proxy) StateNetworkName(in0 context.Context) (out0 types.NetworkName, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api StateNetworkName %v", err)
+		return
+	}
+	return cli.StateNetworkName(in0)
+}
+
+func (p *Proxy) StateNetworkVersion(in0 context.Context, in1 types.TipSetKey) (out0 network.Version, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api StateNetworkVersion %v", err)
+		return
+	}
+	return cli.StateNetworkVersion(in0, in1)
+}
+
+func (p *Proxy) StateReadState(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *types.ActorState, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateReadState %v", err)
+		return
+	}
+	return cli.StateReadState(in0, in1, in2)
+}
+
+func (p *Proxy) StateReplay(in0 context.Context, in1 types.TipSetKey, in2 cid.Cid) (out0 *types.InvocResult, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api StateReplay %v", err)
+		return
+	}
+	return cli.StateReplay(in0, in1, in2)
+}
+
+func (p *Proxy) StateSectorExpiration(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 *miner2.SectorExpiration, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api StateSectorExpiration %v", err)
+		return
+	}
+	return cli.StateSectorExpiration(in0, in1, in2, in3)
+}
+
+func (p *Proxy) StateSectorGetInfo(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 *miner.SectorOnChainInfo, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api StateSectorGetInfo %v", err)
+		return
+	}
+	return cli.StateSectorGetInfo(in0, in1, in2, in3)
+}
+
+func (p *Proxy) StateSectorPartition(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 *miner2.SectorLocation, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api StateSectorPartition %v", err)
+		return
+	}
+	return cli.StateSectorPartition(in0, in1, in2, in3)
+}
+
+func (p *Proxy) StateSectorPreCommitInfo(in0 context.Context, in1 address.Address, in2 abi.SectorNumber, in3 types.TipSetKey) (out0 *miner1.SectorPreCommitOnChainInfo, err error) {
+	cli, err := p.Select(in0, in3)
+	if err != nil {
+		err = fmt.Errorf("api StateSectorPreCommitInfo %v", err)
+		return
+	}
+	return cli.StateSectorPreCommitInfo(in0, in1, in2, in3)
+}
+
+func (p *Proxy) StateVMCirculatingSupplyInternal(in0 context.Context, in1 types.TipSetKey) (out0 types.CirculatingSupply, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api StateVMCirculatingSupplyInternal %v", err)
+		return
+	}
+	return cli.StateVMCirculatingSupplyInternal(in0, in1)
+}
+
+func (p *Proxy) StateVerifiedClientStatus(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *big.Int, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateVerifiedClientStatus %v", err)
+		return
+	}
+	return cli.StateVerifiedClientStatus(in0, in1, in2)
+}
+
+func (p *Proxy) StateVerifiedRegistryRootKey(in0 context.Context, in1 types.TipSetKey) (out0 address.Address, err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api StateVerifiedRegistryRootKey %v", err)
+		return
+	}
+	return cli.StateVerifiedRegistryRootKey(in0, in1)
+}
+
+func (p *Proxy) StateVerifierStatus(in0 context.Context, in1 address.Address, in2 types.TipSetKey) (out0 *big.Int, err error) {
+	cli, err := p.Select(in0, in2)
+	if err != nil {
+		err = fmt.Errorf("api StateVerifierStatus %v", err)
+		return
+	}
+	return cli.StateVerifierStatus(in0, in1, in2)
+}
+
+func (p *Proxy) SubscribeActorEventsRaw(in0 context.Context, in1 *types.ActorEventFilter) (out0 <-chan *types.ActorEvent, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api SubscribeActorEventsRaw %v", err)
+		return
+	}
+	return cli.SubscribeActorEventsRaw(in0, in1)
+}
+
+func (p *Proxy) SyncIncomingBlocks(in0 context.Context) (out0 <-chan *types.BlockHeader, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api SyncIncomingBlocks %v", err)
+		return
+	}
+	return cli.SyncIncomingBlocks(in0)
+}
+
+func (p *Proxy) SyncState(in0 context.Context) (out0 *types.SyncState, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api SyncState %v", err)
+		return
+	}
+	return cli.SyncState(in0)
+}
+
+func (p *Proxy) SyncSubmitBlock(in0 context.Context, in1 *types.BlockMsg) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api SyncSubmitBlock %v", err)
+		return
+	}
+	return cli.SyncSubmitBlock(in0, in1)
+}
+
+func (p *Proxy) Version(in0 context.Context) (out0 types.Version, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api Version %v", err)
+		return
+	}
+	return cli.Version(in0)
+}
+
+func (p *Proxy) WalletBalance(in0 context.Context, in1 address.Address) (out0 big.Int, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api WalletBalance %v", err)
+		return
+	}
+	return cli.WalletBalance(in0, in1)
+}
+
+func (p *Proxy) WalletHas(in0 context.Context, in1 address.Address) (out0 bool, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api WalletHas %v", err)
+		return
+	}
+	return cli.WalletHas(in0, in1)
+}
+
+func (p *Proxy) WalletSign(in0 context.Context, in1 address.Address, in2 []uint8, in3 types.MsgMeta) (out0 *crypto.Signature, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api WalletSign %v", err)
+		return
+	}
+	return cli.WalletSign(in0, in1, in2, in3)
+}
+
+func (p *Proxy) Web3ClientVersion(in0 context.Context) (out0 string, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api Web3ClientVersion %v", err)
+		return
+	}
+	return cli.Web3ClientVersion(in0)
+}
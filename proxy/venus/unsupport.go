@@ -0,0 +1,545 @@
+package venus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/filecoin-project/go-state-types/builtin/v8/paych"
+	types1 "github.com/filecoin-project/venus/venus-shared/actors/types"
+	"github.com/filecoin-project/venus/venus-shared/types"
+	"github.com/ipfs-force-community/sophon-co/api/venus"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/metrics"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+)
+
+var _ UnSupportAPI = (*UnSupport)(nil)
+
+type UnSupportAPI interface {
+	venus.UnSupport
+}
+
+type UnSupport struct {
+	Select func(context.Context, types.TipSetKey) (UnSupportAPI, error)
+}
+
+// impl venus.UnSupport
+func (p *UnSupport) ChainDeleteObj(in0 context.Context, in1 cid.Cid) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainDeleteObj %v", err)
+		return
+	}
+	return cli.ChainDeleteObj(in0, in1)
+}
+
+func (p *UnSupport) ChainPutObj(in0 context.Context, in1 blocks.Block) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainPutObj %v", err)
+		return
+	}
+	return cli.ChainPutObj(in0, in1)
+}
+
+func (p *UnSupport) ChainSetHead(in0 context.Context, in1 types.TipSetKey) (err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api ChainSetHead %v", err)
+		return
+	}
+	return cli.ChainSetHead(in0, in1)
+}
+
+func (p *UnSupport) ChainSyncHandleNewTipSet(in0 context.Context, in1 *types.ChainInfo) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api ChainSyncHandleNewTipSet %v", err)
+		return
+	}
+	return cli.ChainSyncHandleNewTipSet(in0, in1)
+}
+
+func (p *UnSupport) Concurrent(in0 context.Context) (out0 int64) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api Concurrent %v", err)
+		return
+	}
+	return cli.Concurrent(in0)
+}
+
+func (p *UnSupport) HasPassword(in0 context.Context) (out0 bool) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api HasPassword %v", err)
+		return
+	}
+	return cli.HasPassword(in0)
+}
+
+func (p *UnSupport) LockWallet(in0 context.Context) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api LockWallet %v", err)
+		return
+	}
+	return cli.LockWallet(in0)
+}
+
+func (p *UnSupport) MpoolBatchPushMessage(in0 context.Context, in1 []*types1.Message, in2 *types.MessageSendSpec) (out0 []*types1.SignedMessage, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolBatchPushMessage %v", err)
+		return
+	}
+	return cli.MpoolBatchPushMessage(in0, in1, in2)
+}
+
+func (p *UnSupport) MpoolClear(in0 context.Context, in1 bool) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolClear %v", err)
+		return
+	}
+	return cli.MpoolClear(in0, in1)
+}
+
+func (p *UnSupport) MpoolDeleteByAdress(in0 context.Context, in1 address.Address) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolDeleteByAdress %v", err)
+		return
+	}
+	return cli.MpoolDeleteByAdress(in0, in1)
+}
+
+func (p *UnSupport) MpoolPushUntrusted(in0 context.Context, in1 *types1.SignedMessage) (out0 cid.Cid, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolPushUntrusted %v", err)
+		return
+	}
+	return cli.MpoolPushUntrusted(in0, in1)
+}
+
+func (p *UnSupport) MpoolSetConfig(in0 context.Context, in1 *types.MpoolConfig) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api MpoolSetConfig %v", err)
+		return
+	}
+	return cli.MpoolSetConfig(in0, in1)
+}
+
+func (p *UnSupport) NetAgentVersion(in0 context.Context, in1 peer.ID) (out0 string, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetAgentVersion %v", err)
+		return
+	}
+	return cli.NetAgentVersion(in0, in1)
+}
+
+func (p *UnSupport) NetAutoNatStatus(in0 context.Context) (out0 types.NatInfo, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetAutoNatStatus %v", err)
+		return
+	}
+	return cli.NetAutoNatStatus(in0)
+}
+
+func (p *UnSupport) NetBandwidthStats(in0 context.Context) (out0 metrics.Stats, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetBandwidthStats %v", err)
+		return
+	}
+	return cli.NetBandwidthStats(in0)
+}
+
+func (p *UnSupport) NetBandwidthStatsByPeer(in0 context.Context) (out0 map[string]metrics.Stats, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetBandwidthStatsByPeer %v", err)
+		return
+	}
+	return cli.NetBandwidthStatsByPeer(in0)
+}
+
+func (p *UnSupport) NetBandwidthStatsByProtocol(in0 context.Context) (out0 map[protocol.ID]metrics.Stats, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetBandwidthStatsByProtocol %v", err)
+		return
+	}
+	return cli.NetBandwidthStatsByProtocol(in0)
+}
+
+func (p *UnSupport) NetConnect(in0 context.Context, in1 peer.AddrInfo) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetConnect %v", err)
+		return
+	}
+	return cli.NetConnect(in0, in1)
+}
+
+func (p *UnSupport) NetConnectedness(in0 context.Context, in1 peer.ID) (out0 network.Connectedness, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetConnectedness %v", err)
+		return
+	}
+	return cli.NetConnectedness(in0, in1)
+}
+
+func (p *UnSupport) NetDisconnect(in0 context.Context, in1 peer.ID) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetDisconnect %v", err)
+		return
+	}
+	return cli.NetDisconnect(in0, in1)
+}
+
+func (p *UnSupport) NetFindPeer(in0 context.Context, in1 peer.ID) (out0 peer.AddrInfo, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetFindPeer %v", err)
+		return
+	}
+	return cli.NetFindPeer(in0, in1)
+}
+
+func (p *UnSupport) NetFindProvidersAsync(in0 context.Context, in1 cid.Cid, in2 int) (out0 <-chan peer.AddrInfo) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetFindProvidersAsync %v", err)
+		return
+	}
+	return cli.NetFindProvidersAsync(in0, in1, in2)
+}
+
+func (p *UnSupport) NetGetClosestPeers(in0 context.Context, in1 string) (out0 []peer.ID, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetGetClosestPeers %v", err)
+		return
+	}
+	return cli.NetGetClosestPeers(in0, in1)
+}
+
+func (p *UnSupport) NetPeerInfo(in0 context.Context, in1 peer.ID) (out0 *types.ExtendedPeerInfo, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetPeerInfo %v", err)
+		return
+	}
+	return cli.NetPeerInfo(in0, in1)
+}
+
+func (p *UnSupport) NetPeers(in0 context.Context) (out0 []peer.AddrInfo, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetPeers %v", err)
+		return
+	}
+	return cli.NetPeers(in0)
+}
+
+func (p *UnSupport) NetPing(in0 context.Context, in1 peer.ID) (out0 time.Duration, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetPing %v", err)
+		return
+	}
+	return cli.NetPing(in0, in1)
+}
+
+func (p *UnSupport) NetProtectList(in0 context.Context) (out0 []peer.ID, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetProtectList %v", err)
+		return
+	}
+	return cli.NetProtectList(in0)
+}
+
+func (p *UnSupport) NetProtectRemove(in0 context.Context, in1 []peer.ID) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetProtectRemove %v", err)
+		return
+	}
+	return cli.NetProtectRemove(in0, in1)
+}
+
+func (p *UnSupport) NetPubsubScores(in0 context.Context) (out0 []types.PubsubScore, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api NetPubsubScores %v", err)
+		return
+	}
+	return cli.NetPubsubScores(in0)
+}
+
+func (p *UnSupport) PaychAllocateLane(in0 context.Context, in1 address.Address) (out0 uint64, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychAllocateLane %v", err)
+		return
+	}
+	return cli.PaychAllocateLane(in0, in1)
+}
+
+func (p *UnSupport) PaychAvailableFunds(in0 context.Context, in1 address.Address) (out0 *types.ChannelAvailableFunds, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychAvailableFunds %v", err)
+		return
+	}
+	return cli.PaychAvailableFunds(in0, in1)
+}
+
+func (p *UnSupport) PaychAvailableFundsByFromTo(in0 context.Context, in1 address.Address, in2 address.Address) (out0 *types.ChannelAvailableFunds, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychAvailableFundsByFromTo %v", err)
+		return
+	}
+	return cli.PaychAvailableFundsByFromTo(in0, in1, in2)
+}
+
+func (p *UnSupport) PaychCollect(in0 context.Context, in1 address.Address) (out0 cid.Cid, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychCollect %v", err)
+		return
+	}
+	return cli.PaychCollect(in0, in1)
+}
+
+func (p *UnSupport) PaychFund(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int) (out0 *types.ChannelInfo, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychFund %v", err)
+		return
+	}
+	return cli.PaychFund(in0, in1, in2, in3)
+}
+
+func (p *UnSupport) PaychGet(in0 context.Context, in1 address.Address, in2 address.Address, in3 big.Int, in4 types.PaychGetOpts) (out0 *types.ChannelInfo, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychGet %v", err)
+		return
+	}
+	return cli.PaychGet(in0, in1, in2, in3, in4)
+}
+
+func (p *UnSupport) PaychGetWaitReady(in0 context.Context, in1 cid.Cid) (out0 address.Address, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychGetWaitReady %v", err)
+		return
+	}
+	return cli.PaychGetWaitReady(in0, in1)
+}
+
+func (p *UnSupport) PaychNewPayment(in0 context.Context, in1 address.Address, in2 address.Address, in3 []types.VoucherSpec) (out0 *types.PaymentInfo, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychNewPayment %v", err)
+		return
+	}
+	return cli.PaychNewPayment(in0, in1, in2, in3)
+}
+
+func (p *UnSupport) PaychSettle(in0 context.Context, in1 address.Address) (out0 cid.Cid, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychSettle %v", err)
+		return
+	}
+	return cli.PaychSettle(in0, in1)
+}
+
+func (p *UnSupport) PaychVoucherAdd(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher, in3 []uint8, in4 big.Int) (out0 big.Int, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychVoucherAdd %v", err)
+		return
+	}
+	return cli.PaychVoucherAdd(in0, in1, in2, in3, in4)
+}
+
+func (p *UnSupport) PaychVoucherCreate(in0 context.Context, in1 address.Address, in2 big.Int, in3 uint64) (out0 *types.VoucherCreateResult, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychVoucherCreate %v", err)
+		return
+	}
+	return cli.PaychVoucherCreate(in0, in1, in2, in3)
+}
+
+func (p *UnSupport) PaychVoucherList(in0 context.Context, in1 address.Address) (out0 []*paych.SignedVoucher, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychVoucherList %v", err)
+		return
+	}
+	return cli.PaychVoucherList(in0, in1)
+}
+
+func (p *UnSupport) PaychVoucherSubmit(in0 context.Context, in1 address.Address, in2 *paych.SignedVoucher, in3 []uint8, in4 []uint8) (out0 cid.Cid, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api PaychVoucherSubmit %v", err)
+		return
+	}
+	return cli.PaychVoucherSubmit(in0, in1, in2, in3, in4)
+}
+
+func (p *UnSupport) SetConcurrent(in0 context.Context, in1 int64) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api SetConcurrent %v", err)
+		return
+	}
+	return cli.SetConcurrent(in0, in1)
+}
+
+func (p *UnSupport) SetPassword(in0 context.Context, in1 []uint8) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api SetPassword %v", err)
+		return
+	}
+	return cli.SetPassword(in0, in1)
+}
+
+func (p *UnSupport) SyncCheckpoint(in0 context.Context, in1 types.TipSetKey) (err error) {
+	cli, err := p.Select(in0, in1)
+	if err != nil {
+		err = fmt.Errorf("api SyncCheckpoint %v", err)
+		return
+	}
+	return cli.SyncCheckpoint(in0, in1)
+}
+
+func (p *UnSupport) SyncerTracker(in0 context.Context) (out0 *types.TargetTracker) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api SyncerTracker %v", err)
+		return
+	}
+	return cli.SyncerTracker(in0)
+}
+
+func (p *UnSupport) UnLockWallet(in0 context.Context, in1 []uint8) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api UnLockWallet %v", err)
+		return
+	}
+	return cli.UnLockWallet(in0, in1)
+}
+
+func (p *UnSupport) VerifyEntry(in0 *types.BeaconEntry, in1 *types.BeaconEntry, in2 abi.ChainEpoch) (out0 bool) {
+	cli, err := p.Select(context.TODO(), types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api VerifyEntry %v", err)
+		return
+	}
+	return cli.VerifyEntry(in0, in1, in2)
+}
+
+func (p *UnSupport) WalletAddresses(in0 context.Context) (out0 []address.Address) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api WalletAddresses %v", err)
+		return
+	}
+	return cli.WalletAddresses(in0)
+}
+
+func (p *UnSupport) WalletDefaultAddress(in0 context.Context) (out0 address.Address, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api WalletDefaultAddress %v", err)
+		return
+	}
+	return cli.WalletDefaultAddress(in0)
+}
+
+func (p *UnSupport) WalletDelete(in0 context.Context, in1 address.Address) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api WalletDelete %v", err)
+		return
+	}
+	return cli.WalletDelete(in0, in1)
+}
+
+func (p *UnSupport) WalletExport(in0 context.Context, in1 address.Address, in2 string) (out0 *types.KeyInfo, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api WalletExport %v", err)
+		return
+	}
+	return cli.WalletExport(in0, in1, in2)
+}
+
+func (p *UnSupport) WalletImport(in0 context.Context, in1 *types.KeyInfo) (out0 address.Address, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api WalletImport %v", err)
+		return
+	}
+	return cli.WalletImport(in0, in1)
+}
+
+func (p *UnSupport) WalletNewAddress(in0 context.Context, in1 uint8) (out0 address.Address, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api WalletNewAddress %v", err)
+		return
+	}
+	return cli.WalletNewAddress(in0, in1)
+}
+
+func (p *UnSupport) WalletSetDefault(in0 context.Context, in1 address.Address) (err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api WalletSetDefault %v", err)
+		return
+	}
+	return cli.WalletSetDefault(in0, in1)
+}
+
+func (p *UnSupport) WalletSignMessage(in0 context.Context, in1 address.Address, in2 *types1.Message) (out0 *types1.SignedMessage, err error) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api WalletSignMessage %v", err)
+		return
+	}
+	return cli.WalletSignMessage(in0, in1, in2)
+}
+
+func (p *UnSupport) WalletState(in0 context.Context) (out0 int) {
+	cli, err := p.Select(in0, types.EmptyTSK)
+	if err != nil {
+		err = fmt.Errorf("api WalletState %v", err)
+		return
+	}
+	return cli.WalletState(in0)
+}
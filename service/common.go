@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"go.uber.org/fx"
+
+	apitypes "github.com/filecoin-project/lotus/api/types"
+	"github.com/filecoin-project/lotus/build"
+
+	"github.com/ipfs-force-community/sophon-co/api"
+	"github.com/ipfs-force-community/sophon-co/version"
+)
+
+// NewCommonService constructs a CommonService instance, the closing channel is closed when the app stops
+func NewCommonService(lc fx.Lifecycle) (*CommonService, error) {
+	_, pub, err := crypto.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate identity: %w", err)
+	}
+
+	id, err := peer.IDFromPublicKey(pub)
+	if err != nil {
+		return nil, fmt.Errorf("generate identity: %w", err)
+	}
+
+	cs := &CommonService{
+		session: uuid.New(),
+		start:   time.Now(),
+		id:      id,
+		closing: make(chan struct{}),
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			close(cs.closing)
+			return nil
+		},
+	})
+
+	return cs, nil
+}
+
+// CommonService impls the Common methods of api.Local, all of them are about sophon-co itself
+type CommonService struct {
+	session uuid.UUID
+	start   time.Time
+	id      peer.ID
+	closing chan struct{}
+}
+
+// Discover impls api.FullNode.Discover, only the methods served by sophon-co are listed
+func (cs *CommonService) Discover(ctx context.Context) (apitypes.OpenRPCDocument, error) {
+	doc := build.OpenRPCDiscoverJSON_Full()
+
+	served := map[string]struct{}{}
+	for _, typ := range []reflect.Type{
+		reflect.TypeOf((*api.Proxy)(nil)).Elem(),
+		reflect.TypeOf((*api.Local)(nil)).Elem(),
+	} {
+		for i := 0; i < typ.NumMethod(); i++ {
+			served["Filecoin."+typ.Method(i).Name] = struct{}{}
+		}
+	}
+
+	if methods, ok := doc["methods"].([]interface{}); ok {
+		filtered := make([]interface{}, 0, len(methods))
+		for _, meth := range methods {
+			m, ok := meth.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if name, _ := m["name"].(string); name != "" {
+				if _, ok := served[name]; ok {
+					filtered = append(filtered, meth)
+				}
+			}
+		}
+		doc["methods"] = filtered
+	}
+
+	if info, ok := doc["info"].(map[string]interface{}); ok {
+		info["title"] = "sophon-co RPC API"
+		info["version"] = version.Version + version.CurrentCommit
+	}
+
+	return doc, nil
+}
+
+// ID impls api.FullNode.ID, it's generated for each session, since sophon-co has no libp2p host
+func (cs *CommonService) ID(context.Context) (peer.ID, error) {
+	return cs.id, nil
+}
+
+// LogList impls api.FullNode.LogList
+func (cs *CommonService) LogList(context.Context) ([]string, error) {
+	return logging.GetSubsystems(), nil
+}
+
+// LogSetLevel impls api.FullNode.LogSetLevel
+func (cs *CommonService) LogSetLevel(_ context.Context, subsystem, level string) error {
+	return logging.SetLogLevel(subsystem, level)
+}
+
+// Session impls api.FullNode.Session
+func (cs *CommonService) Session(context.Context) (uuid.UUID, error) {
+	return cs.session, nil
+}
+
+// StartTime impls api.FullNode.StartTime
+func (cs *CommonService) StartTime(context.Context) (time.Time, error) {
+	return cs.start, nil
+}
+
+// Closing impls api.FullNode.Closing
+func (cs *CommonService) Closing(context.Context) (<-chan struct{}, error) {
+	return cs.closing, nil
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/fx/fxtest"
+)
+
+func TestCommonService(t *testing.T) {
+	ctx := context.Background()
+	lc := fxtest.NewLifecycle(t)
+	cs, err := NewCommonService(lc)
+	assert.NoError(t, err)
+	lc.RequireStart()
+
+	session, err := cs.Session(ctx)
+	assert.NoError(t, err)
+	again, err := cs.Session(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, session, again)
+
+	doc, err := cs.Discover(ctx)
+	assert.NoError(t, err)
+	names := map[string]bool{}
+	for _, meth := range doc["methods"].([]interface{}) {
+		names[meth.(map[string]interface{})["name"].(string)] = true
+	}
+	assert.True(t, names["Filecoin.ChainHead"])
+	assert.True(t, names["Filecoin.StateMinerInfo"])
+	assert.True(t, names["Filecoin.StateWaitMsg"])
+	assert.False(t, names["Filecoin.WalletNew"])
+	assert.False(t, names["Filecoin.Shutdown"])
+
+	closing, err := cs.Closing(ctx)
+	assert.NoError(t, err)
+	select {
+	case <-closing:
+		t.Fatal("should not be closed before stop")
+	default:
+	}
+
+	lc.RequireStop()
+	<-closing
+}
@@ -3,21 +3,30 @@ package service
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/dtynn/dix"
 	"go.uber.org/fx"
 
+	"github.com/ipfs-force-community/sophon-co/apiwrap"
 	local_api "github.com/ipfs-force-community/sophon-co/cli/api"
 	"github.com/ipfs-force-community/sophon-co/co"
+	"github.com/ipfs-force-community/sophon-co/config"
+	"github.com/ipfs-force-community/sophon-co/cost"
 	"github.com/ipfs-force-community/sophon-co/proxy"
+	"github.com/ipfs-force-community/sophon-co/proxy/venus"
 
 	"github.com/filecoin-project/lotus/api"
 	"github.com/filecoin-project/lotus/chain/types"
+	v1 "github.com/filecoin-project/venus/venus-shared/api/chain/v1"
+	vtypes "github.com/filecoin-project/venus/venus-shared/types"
 )
 
 const extractFullNodeAPIKey dix.Invoke = 1
 const extractLocalAPIKey dix.Invoke = 2
+const extractHealthKey dix.Invoke = 3
+const extractVenusFullNodeKey dix.Invoke = 4
 
 // Build constructs the app with given di options
 func Build(ctx context.Context, overrides ...dix.Option) (dix.StopFunc, error) {
@@ -25,24 +34,49 @@ func Build(ctx context.Context, overrides ...dix.Option) (dix.StopFunc, error) {
 		dix.Override(new(co.NodeOption), co.DefaultNodeOption),
 		dix.Override(new(*co.Ctx), co.NewCtx),
 		dix.Override(new(co.INodeStore), co.NewNodeStore),
+		dix.Override(new(*co.HeadStore), func() *co.HeadStore { return co.NewHeadStore("") }),
 		dix.Override(new(*co.Coordinator), buildCoordinator),
 		dix.Override(new(*co.Selector), co.NewSelector),
+		dix.Override(new(co.PoolOption), co.DefaultPoolOption),
+		dix.Override(new(*co.Pools), co.NewPools),
+		dix.Override(new(co.HealthOption), co.DefaultHealthOption),
+		dix.Override(new(*co.Health), co.NewHealth),
+		dix.Override(new(co.GasAggregateOption), co.DefaultGasAggregateOption),
+		dix.Override(new(*co.GasEstimator), co.NewGasEstimator),
+		dix.Override(new(*CommonService), NewCommonService),
 		dix.Override(new(*proxy.Proxy), buildProxyAPI),
 		dix.Override(new(*proxy.Local), buildLocalAPI),
 		dix.Override(new(*proxy.UnSupport), buildUnSupportAPI),
+		dix.Override(new(*venus.Proxy), buildVenusProxyAPI),
+		dix.Override(new(*venus.Local), buildVenusLocalAPI),
+		dix.Override(new(*venus.UnSupport), buildVenusUnSupportAPI),
 	}
 	opts = append(opts, overrides...)
 	return dix.New(ctx, opts...)
 }
 
-// FullNode extracts api.FullNode from inside di
+// FullNode extracts api.FullNode from inside di,
+// the name of the method is set in the ctx of each call, so that it's routed by the capabilities it requires
 func FullNode(full *api.FullNode) dix.Option {
 	return dix.Override(extractFullNodeAPIKey, func(srv Service) error {
-		*full = &srv
+		*full = apiwrap.FullNode(&srv, withMethod)
 		return nil
 	})
 }
 
+// VenusFullNode extracts v1.FullNode of venus from inside di, the calls are routed to the venus nodes as FullNode does
+func VenusFullNode(full *v1.FullNode) dix.Option {
+	return dix.Override(extractVenusFullNodeKey, func(srv VenusService) error {
+		*full = apiwrap.VenusFullNode(&srv, withMethod)
+		return nil
+	})
+}
+
+func withMethod(method string, fn reflect.Value, args []reflect.Value) []reflect.Value {
+	args[0] = reflect.ValueOf(co.WithMethod(args[0].Interface().(context.Context), method))
+	return fn.Call(args)
+}
+
 // FullNode extracts api.FullNode from inside di
 func LocalAPI(api *local_api.LocalAPI) dix.Option {
 	return dix.Override(extractLocalAPIKey, func(srv LocalAPIService) error {
@@ -51,12 +85,22 @@ func LocalAPI(api *local_api.LocalAPI) dix.Option {
 	})
 }
 
+// Health extracts *co.Health from inside di
+func Health(health **co.Health) dix.Option {
+	return dix.Override(extractHealthKey, func(h *co.Health) error {
+		*health = h
+		return nil
+	})
+}
+
 // ParseNodeInfoList is provided to the higer-lvel
-func ParseNodeInfoList(raws []string, version string) dix.Option {
+func ParseNodeInfoList(nodes []config.NodeConfig, version string) dix.Option {
 	return dix.Override(new(co.NodeInfoList), func() (co.NodeInfoList, error) {
-		list := make(co.NodeInfoList, 0, len(raws))
-		for _, str := range raws {
-			info := co.NewNodeInfo(str, version)
+		list := make(co.NodeInfoList, 0, len(nodes))
+		for _, node := range nodes {
+			info := co.NewNodeInfo(node.TokenURL, version, node.Labels...)
+			info.MaxInFlight = node.MaxInFlight
+			info.Name = node.Name
 			list = append(list, info)
 		}
 
@@ -64,7 +108,61 @@ func ParseNodeInfoList(raws []string, version string) dix.Option {
 	})
 }
 
-func buildCoordinator(lc fx.Lifecycle, ctx *co.Ctx, infos co.NodeInfoList, sel *co.Selector) (*co.Coordinator, error) {
+// NodeOption is provided to the higer-lvel
+func NodeOption(cfg config.UpstreamConfig, costCfg config.CostConfig) dix.Option {
+	return dix.Override(new(co.NodeOption), func() co.NodeOption {
+		opt := co.DefaultNodeOption()
+		opt.MaxInFlight = cfg.MaxInFlight
+		opt.QueueSize = cfg.QueueSize
+		opt.QueueTimeout = cfg.QueueTimeout
+		opt.Costs = cost.New(costCfg.Methods)
+		opt.Network = cfg.Network
+		return opt
+	})
+}
+
+// PoolOption is provided to the higer-lvel
+func PoolOption(cfg config.PoolConfig) dix.Option {
+	return dix.Override(new(co.PoolOption), func() co.PoolOption {
+		return co.PoolOption{
+			Users:    cfg.Users,
+			Fallback: cfg.Fallback,
+		}
+	})
+}
+
+// HealthOption is provided to the higer-lvel
+func HealthOption(cfg config.HealthConfig) dix.Option {
+	return dix.Override(new(co.HealthOption), func() co.HealthOption {
+		return co.HealthOption{
+			MinCaughtUp:  cfg.MinCaughtUp,
+			MaxHeadDrift: cfg.MaxHeadDrift,
+		}
+	})
+}
+
+// HeadStoreOption persists the head of the coordinator in the file
+func HeadStoreOption(path string) dix.Option {
+	return dix.Override(new(*co.HeadStore), func() *co.HeadStore {
+		return co.NewHeadStore(path)
+	})
+}
+
+// GasAggregateOption is provided to the higer-lvel
+func GasAggregateOption(cfg config.GasEstimateConfig) dix.Option {
+	return dix.Override(new(co.GasAggregateOption), func() co.GasAggregateOption {
+		return co.GasAggregateOption{
+			Enable:           cfg.Aggregate,
+			Nodes:            cfg.Nodes,
+			Timeout:          cfg.Timeout,
+			GasLimitPolicy:   cfg.GasLimitPolicy,
+			GasFeeCapPolicy:  cfg.GasFeeCapPolicy,
+			GasPremiumPolicy: cfg.GasPremiumPolicy,
+		}
+	})
+}
+
+func buildCoordinator(lc fx.Lifecycle, ctx *co.Ctx, infos co.NodeInfoList, sel *co.Selector, hs *co.HeadStore) (*co.Coordinator, error) {
 	nodes := make([]*co.Node, 0, len(infos))
 	allDone := false
 	defer func() {
@@ -92,10 +190,15 @@ func buildCoordinator(lc fx.Lifecycle, ctx *co.Ctx, infos co.NodeInfoList, sel *
 		nodes = append(nodes, node)
 
 		if err := node.Connect(); err == nil {
+			// the node keeps being checked in background
+			if err := node.CheckNetwork(); err != nil {
+				nlog.Errorf("check network: %s", err)
+				continue
+			}
+
 			full := node.FullNode()
 			h, w, err := getHeadCandidate(full)
 			if err != nil {
-				node.Stop() // nolint:errcheck
 				nlog.Errorf("failed to get head: %s", err)
 			} else {
 				if head == nil || w.GreaterThan(weight) {
@@ -108,15 +211,16 @@ func buildCoordinator(lc fx.Lifecycle, ctx *co.Ctx, infos co.NodeInfoList, sel *
 		}
 	}
 
-	if head == nil {
-		return nil, fmt.Errorf("no available node")
-	}
-
-	coordinator, err := co.NewCoordinator(ctx, head, weight, sel)
+	coordinator, err := co.NewCoordinator(ctx, head, weight, sel, hs)
 	if err != nil {
 		return nil, err
 	}
 
+	// the nodes keep reconnecting in background, the coordinator works once the first head arrives
+	if h, _, _ := coordinator.HeadState(); h == nil {
+		log.Warn("no available node, start in the degraded mode")
+	}
+
 	lc.Append(fx.Hook{
 		OnStart: func(context.Context) error {
 			go coordinator.Start()
@@ -152,8 +256,8 @@ func getHeadCandidate(full api.FullNode) (*types.TipSet, types.BigInt, error) {
 
 func buildProxyAPI(sel *co.Selector) *proxy.Proxy {
 	return &proxy.Proxy{
-		Select: func(tsk types.TipSetKey) (proxy.ProxyAPI, error) {
-			node, err := sel.Select(tsk)
+		Select: func(ctx context.Context, tsk types.TipSetKey) (proxy.ProxyAPI, error) {
+			node, err := sel.Select(ctx, tsk)
 			if err != nil {
 				return nil, err
 			}
@@ -165,7 +269,7 @@ func buildProxyAPI(sel *co.Selector) *proxy.Proxy {
 
 func buildLocalAPI(lsrv LocalChainService) *proxy.Local {
 	return &proxy.Local{
-		Select: func(_ types.TipSetKey) (proxy.LocalAPI, error) {
+		Select: func(_ context.Context, _ types.TipSetKey) (proxy.LocalAPI, error) {
 			return &lsrv, nil
 		},
 	}
@@ -173,7 +277,44 @@ func buildLocalAPI(lsrv LocalChainService) *proxy.Local {
 
 func buildUnSupportAPI() *proxy.UnSupport {
 	return &proxy.UnSupport{
-		Select: func(_ types.TipSetKey) (proxy.UnSupportAPI, error) {
+		Select: func(_ context.Context, _ types.TipSetKey) (proxy.UnSupportAPI, error) {
+			return nil, fmt.Errorf("api not supported")
+		},
+	}
+}
+
+func buildVenusProxyAPI(sel *co.Selector) *venus.Proxy {
+	return &venus.Proxy{
+		Select: func(ctx context.Context, tsk vtypes.TipSetKey) (venus.ProxyAPI, error) {
+			node, err := sel.Select(co.WithVenusAPI(ctx), types.NewTipSetKey(tsk.Cids()...))
+			if err != nil {
+				return nil, err
+			}
+
+			// the venus client is dropped if the node turns out to be another implementation in the meantime
+			full := node.VenusFullNode()
+			if full == nil {
+				return nil, fmt.Errorf("%w: %s is not a venus node", co.ErrNoNodeAvailable, node.Addr)
+			}
+			log.Debugf("select venus node %s", node.Addr)
+			return full, nil
+		},
+	}
+}
+
+// buildVenusLocalAPI serves the local methods of venus by the ones of lotus, the types are converted between them
+func buildVenusLocalAPI(lsrv LocalChainService) *venus.Local {
+	local := apiwrap.ConvertToVenus(&lsrv)
+	return &venus.Local{
+		Select: func(_ context.Context, _ vtypes.TipSetKey) (venus.LocalAPI, error) {
+			return local, nil
+		},
+	}
+}
+
+func buildVenusUnSupportAPI() *venus.UnSupport {
+	return &venus.UnSupport{
+		Select: func(_ context.Context, _ vtypes.TipSetKey) (venus.UnSupportAPI, error) {
 			return nil, fmt.Errorf("api not supported")
 		},
 	}
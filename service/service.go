@@ -2,13 +2,18 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"time"
 
+	"github.com/filecoin-project/go-state-types/abi"
 	logging "github.com/ipfs/go-log/v2"
 	"go.uber.org/fx"
 
 	local_api "github.com/ipfs-force-community/sophon-co/cli/api"
 	"github.com/ipfs-force-community/sophon-co/co"
 	"github.com/ipfs-force-community/sophon-co/proxy"
+	"github.com/ipfs-force-community/sophon-co/proxy/venus"
 )
 
 var log = logging.Logger("sophon-co-srv")
@@ -17,6 +22,8 @@ var log = logging.Logger("sophon-co-srv")
 type LocalChainService struct {
 	fx.In
 	*co.Coordinator
+	*co.GasEstimator
+	*CommonService
 }
 
 // Service impls api.FullNode
@@ -28,10 +35,21 @@ type Service struct {
 	*proxy.UnSupport
 }
 
+// VenusService impls v1.FullNode of venus
+type VenusService struct {
+	fx.In
+
+	*venus.Proxy
+	*venus.Local
+	*venus.UnSupport
+}
+
 // LocalAPIService impls cli/api.LocalAPI
 type LocalAPIService struct {
 	fx.In
 	*co.Selector
+	*co.Pools
+	Coordinator *co.Coordinator
 }
 
 var _ local_api.LocalAPI = (*LocalAPIService)(nil)
@@ -47,3 +65,173 @@ func (l *LocalAPIService) ListWeight(ctx context.Context) (map[string]int, error
 func (l *LocalAPIService) ListPriority(ctx context.Context) (map[string]int, error) {
 	return l.Selector.ListPriority(), nil
 }
+
+func (l *LocalAPIService) SetUserPool(ctx context.Context, user string, pool string) error {
+	l.Pools.SetUserPool(user, pool)
+	return nil
+}
+
+func (l *LocalAPIService) ListUserPools(ctx context.Context) (map[string]string, error) {
+	return l.Pools.ListUserPools(), nil
+}
+
+func (l *LocalAPIService) ListNodeLabels(ctx context.Context) (map[string][]string, error) {
+	return l.Selector.ListLabels(), nil
+}
+
+func (l *LocalAPIService) CoordinatorState(ctx context.Context) (*local_api.CoordinatorState, error) {
+	head, weight, headNodes := l.Coordinator.HeadState()
+	state := &local_api.CoordinatorState{
+		Weight:    weight,
+		HeadNodes: headNodes,
+	}
+	if head != nil {
+		state.Head = head.Key()
+		state.Height = head.Height()
+	}
+
+	state.Nodes = l.nodeStates(state.Height)
+	return state, nil
+}
+
+func (l *LocalAPIService) ListNodes(ctx context.Context) ([]local_api.NodeState, error) {
+	head, _, _ := l.Coordinator.HeadState()
+	var height abi.ChainEpoch
+	if head != nil {
+		height = head.Height()
+	}
+
+	return l.nodeStates(height), nil
+}
+
+func (l *LocalAPIService) NodeDetail(ctx context.Context, addr string) (*local_api.NodeDetail, error) {
+	node := l.Selector.Node(addr)
+	if node == nil {
+		return nil, fmt.Errorf("node %s not found", addr)
+	}
+
+	head, _, _ := l.Coordinator.HeadState()
+	var height abi.ChainEpoch
+	if head != nil {
+		height = head.Height()
+	}
+
+	detail := &local_api.NodeDetail{
+		NodeState: nodeState(l.Selector, node, height, l.Selector.ListPriority()[addr], l.Selector.Weight(addr), l.Selector.BlockReasons()),
+	}
+
+	full := node.FullNode()
+	if full == nil {
+		detail.Errors = append(detail.Errors, "not connected")
+		return detail, nil
+	}
+
+	var err error
+	if detail.APIVersion, err = full.Version(ctx); err != nil {
+		detail.Errors = append(detail.Errors, fmt.Sprintf("call Version: %s", err))
+	}
+	if detail.SyncState, err = full.SyncState(ctx); err != nil {
+		detail.Errors = append(detail.Errors, fmt.Sprintf("call SyncState: %s", err))
+	}
+
+	return detail, nil
+}
+
+func (l *LocalAPIService) BlockNode(ctx context.Context, addr string, reason string) error {
+	return l.Selector.Block(addr, reason)
+}
+
+func (l *LocalAPIService) UnblockNode(ctx context.Context, addr string) error {
+	return l.Selector.Unblock(addr)
+}
+
+func (l *LocalAPIService) ReconnectNode(ctx context.Context, addr string) error {
+	node := l.Selector.Node(addr)
+	if node == nil {
+		return fmt.Errorf("node %s not found", addr)
+	}
+
+	return node.Reconnect()
+}
+
+func (l *LocalAPIService) DrainNode(ctx context.Context, addr string, timeout time.Duration) (*local_api.DrainResult, error) {
+	node := l.Selector.Node(addr)
+	if node == nil {
+		return nil, fmt.Errorf("node %s not found", addr)
+	}
+
+	if err := l.Selector.Drain(addr); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := node.WaitIdle(ctx)
+	if err != nil {
+		log.Warnf("drain node %s: %s", addr, err)
+	}
+
+	return &local_api.DrainResult{
+		Addr:     addr,
+		Drained:  err == nil,
+		InFlight: node.InFlight(),
+	}, nil
+}
+
+func (l *LocalAPIService) UndrainNode(ctx context.Context, addr string) error {
+	return l.Selector.Undrain(addr)
+}
+
+// nodeStates returns the state of all the nodes ordered by the addresses, height is the head of the coordinator
+func (l *LocalAPIService) nodeStates(height abi.ChainEpoch) []local_api.NodeState {
+	weights := l.Selector.ListWeight()
+	reasons := l.Selector.BlockReasons()
+
+	var states []local_api.NodeState
+	for addr, priority := range l.Selector.ListPriority() {
+		if node := l.Selector.Node(addr); node != nil {
+			states = append(states, nodeState(l.Selector, node, height, priority, weights[addr], reasons))
+		}
+	}
+
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].Addr < states[j].Addr
+	})
+	return states
+}
+
+func nodeState(sel *co.Selector, node *co.Node, height abi.ChainEpoch, priority, weight int, reasons map[string]string) local_api.NodeState {
+	conn := node.ConnState()
+	reason, blocked := reasons[node.Addr]
+	draining := sel.Draining(node.Addr)
+	ns := local_api.NodeState{
+		Addr:         node.Addr,
+		Name:         node.Name,
+		Labels:       node.Labels,
+		Priority:     priority,
+		Weight:       weight,
+		BlockReason:  reason,
+		Blocked:      blocked,
+		Draining:     draining,
+		InFlight:     node.InFlight(),
+		Refused:      node.Refused(),
+		Network:      conn.Network.Name,
+		HeadWeight:   conn.HeadWeight,
+		Connected:    conn.Connected,
+		LastError:    conn.LastError,
+		LastErrorAt:  conn.LastErrorAt,
+		Version:      conn.Version,
+		Impl:         conn.Capabilities.Impl,
+		Capabilities: conn.Capabilities.List(),
+		Latency:      node.Latency(),
+	}
+	if nodeHead := node.Head(); nodeHead != nil {
+		ns.Head = nodeHead.Key()
+		ns.Height = nodeHead.Height()
+		ns.Lag = height - nodeHead.Height()
+		ns.SinceHeadChange = time.Since(conn.HeadAt)
+	}
+
+	return ns
+}
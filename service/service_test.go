@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ipfs-force-community/sophon-co/api/venus"
+	"github.com/ipfs-force-community/sophon-co/proxy"
+)
+
+// mockLocalAPI overrides the methods needed by the tests, others will panic
+type mockLocalAPI struct {
+	proxy.LocalAPI
+
+	limits []abi.ChainEpoch
+}
+
+func (m *mockLocalAPI) StateSearchMsgLimited(_ context.Context, msg cid.Cid, limit abi.ChainEpoch) (*api.MsgLookup, error) {
+	m.limits = append(m.limits, limit)
+	return &api.MsgLookup{Message: msg}, nil
+}
+
+func (m *mockLocalAPI) StateWaitMsgLimited(_ context.Context, msg cid.Cid, _ uint64, limit abi.ChainEpoch) (*api.MsgLookup, error) {
+	m.limits = append(m.limits, limit)
+	return &api.MsgLookup{Message: msg}, nil
+}
+
+func TestStateMsgLimitedRouted(t *testing.T) {
+	local := &mockLocalAPI{}
+	srv := Service{
+		Local: &proxy.Local{
+			Select: func(context.Context, types.TipSetKey) (proxy.LocalAPI, error) {
+				return local, nil
+			},
+		},
+		UnSupport: buildUnSupportAPI(),
+	}
+
+	ctx := context.Background()
+	lookup, err := srv.StateSearchMsgLimited(ctx, cid.Undef, 10)
+	assert.NoError(t, err)
+	assert.NotNil(t, lookup)
+
+	lookup, err = srv.StateWaitMsgLimited(ctx, cid.Undef, 5, 20)
+	assert.NoError(t, err)
+	assert.NotNil(t, lookup)
+
+	assert.Equal(t, []abi.ChainEpoch{10, 20}, local.limits)
+}
+
+// the local methods of venus are served by the ones of lotus with the same names
+func TestVenusLocalServed(t *testing.T) {
+	lsrv := reflect.TypeOf(&LocalChainService{})
+	local := reflect.TypeOf((*venus.Local)(nil)).Elem()
+	for i := 0; i < local.NumMethod(); i++ {
+		name := local.Method(i).Name
+		_, ok := lsrv.MethodByName(name)
+		assert.True(t, ok, "%s of venus is not served locally", name)
+	}
+}